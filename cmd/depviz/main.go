@@ -13,31 +13,42 @@ import (
 
 	bearer "github.com/Bearer/bearer-go"
 	"github.com/cayleygraph/cayley"
-	"github.com/cayleygraph/cayley/graph"
 	_ "github.com/cayleygraph/cayley/graph/kv/bolt"
+	_ "github.com/cayleygraph/cayley/graph/kv/leveldb"
+	_ "github.com/cayleygraph/cayley/graph/sql/postgres"
+	_ "github.com/cayleygraph/cayley/graph/sql/sqlite"
 	"github.com/cayleygraph/cayley/schema"
+	_ "github.com/cayleygraph/quad/gml"
+	_ "github.com/cayleygraph/quad/graphml"
+	_ "github.com/cayleygraph/quad/json"
+	_ "github.com/cayleygraph/quad/jsonld"
+	_ "github.com/cayleygraph/quad/nquads"
+	_ "github.com/cayleygraph/quad/pquads"
 	"github.com/oklog/run"
 	"github.com/peterbourgon/ff/v3/ffcli"
 	"go.uber.org/zap"
 	"moul.io/banner"
 	"moul.io/depviz/v3/internal/dvcore"
+	"moul.io/depviz/v3/internal/dvmodel"
 	"moul.io/depviz/v3/internal/dvparser"
 	"moul.io/depviz/v3/internal/dvserver"
 	"moul.io/depviz/v3/internal/dvstore"
 	"moul.io/srand"
-	"moul.io/u"
 	"moul.io/zapconfig"
 )
 
 var (
-	logger       *zap.Logger
-	schemaConfig *schema.Config
+	logger         *zap.Logger
+	schemaConfig   *schema.Config
+	snapshotPolicy dvstore.SnapshotPolicy
 
 	globalFlags           = flag.NewFlagSet("depviz", flag.ExitOnError)
-	globalStorePath       = globalFlags.String("store-path", os.Getenv("HOME")+"/.depviz", "store path")
+	globalStorePath       = globalFlags.String("store-path", os.Getenv("HOME")+"/.depviz", "store path (bolt)")
+	globalStoreURI        = globalFlags.String("store-uri", "", "store URI, overrides --store-path (memory://, bolt://<path>, leveldb://<path>, sqlite://<path>, postgres://<dsn>)")
 	globalDebug           = globalFlags.Bool("debug", false, "debug mode")
 	globalWithStacktrace  = globalFlags.Bool("with-stacktrace", false, "show stacktrace on warns, errors and worse")
 	globalBearerSecretKey = globalFlags.String("bearer-secretkey", "", "optional bearer.sh secret key")
+	globalSnapshots       = globalFlags.String("snapshots", "off", "task history recorded when syncing, for --as-of (off, sync, daily)")
 
 	airtableFlags     = flag.NewFlagSet("airtable", flag.ExitOnError)
 	airtableToken     = airtableFlags.String("token", "", "airtable token")
@@ -46,6 +57,27 @@ var (
 	airtableTasksTab  = airtableFlags.String("tasks", "Tasks", `"Tasks" tab name`)
 	airtableTopicsTab = airtableFlags.String("topics", "Topics", `"Topics" tab name`)
 
+	storeDumpQuadsFlags     = flag.NewFlagSet("dump-quads", flag.ExitOnError)
+	storeDumpQuadsFormat    = storeDumpQuadsFlags.String("format", "", "quad format (nquads, pquads, json, jsonld, gml, graphml), guessed from the file extension by default (jsonld drops relative predicates)")
+	storeExportFlags        = flag.NewFlagSet("export", flag.ExitOnError)
+	storeExportFormat       = storeExportFlags.String("format", "", "export format (graphml, gml, jsonld, nquads, pquads), guessed from the file extension by default")
+	storeRestoreQuadsFlags  = flag.NewFlagSet("restore-quads", flag.ExitOnError)
+	storeRestoreQuadsFormat = storeRestoreQuadsFlags.String("format", "", "quad format (nquads, pquads, json, jsonld), guessed from the file extension by default")
+	storeRestoreQuadsMode   = storeRestoreQuadsFlags.String("mode", "merge", "restore mode (merge, replace)")
+	storeRestoreJSONFlags   = flag.NewFlagSet("restore-json", flag.ExitOnError)
+	storeRestoreJSONMode    = storeRestoreJSONFlags.String("mode", "merge", "restore mode (merge, replace)")
+	storeInfoFlags          = flag.NewFlagSet("info", flag.ExitOnError)
+	storeInfoJSON           = storeInfoFlags.Bool("json", false, "JSON output")
+	storeMigrateFlags       = flag.NewFlagSet("migrate", flag.ExitOnError)
+	storeMigrateDryRun      = storeMigrateFlags.Bool("dry-run", false, "only print pending migrations")
+	storeDoctorFlags        = flag.NewFlagSet("doctor", flag.ExitOnError)
+	storeDoctorFix          = storeDoctorFlags.Bool("fix", false, "repair the problems found, each one atomically (dangling relationships are removed)")
+	storePruneFlags         = flag.NewFlagSet("prune", flag.ExitOnError)
+	storePruneClosedDays    = storePruneFlags.Int("closed-older-than-days", 0, "drop closed tasks without activity for N days, unless reachable from an open task (0 disables)")
+	storePruneUnconfigured  = storePruneFlags.Bool("unconfigured-targets", false, "drop repos not matching one of the targets given as arguments, and their tasks")
+	storePruneOrphans       = storePruneFlags.Bool("orphans", false, "drop owners and topics not referenced anymore")
+	storePruneDryRun        = storePruneFlags.Bool("dry-run", false, "only print what would be dropped")
+
 	serverFlags              = flag.NewFlagSet("server", flag.ExitOnError)
 	serverHTTPBind           = serverFlags.String("http-bind", ":8000", "HTTP bind address")
 	serverGRPCBInd           = serverFlags.String("grpc-bind", ":9000", "gRPC bind address")
@@ -63,6 +95,11 @@ var (
 	serverAutoUpdateInterval = serverFlags.Duration("auto-update-interval", 2*time.Minute, "time between two auto-updates") // nolint:gomnd
 	serverGitHubClientID     = serverFlags.String("github-client-id", "", "GitHub client ID")
 	serverGitHubClientSecret = serverFlags.String("github-client-secret", "", "GitHub client secret")
+	serverPruneInterval      = serverFlags.Duration("prune-interval", 0, "time between two store prunings (0 disables)")
+	serverPruneClosedDays    = serverFlags.Int("prune-closed-older-than-days", 0, "prune closed tasks without activity for N days, unless reachable from an open task")
+	serverPruneUnconfigured  = serverFlags.Bool("prune-unconfigured-targets", false, "prune repos not matching one of the server targets")
+	serverPruneOrphans       = serverFlags.Bool("prune-orphans", false, "prune owners and topics not referenced anymore")
+	serverWithIndex          = serverFlags.Bool("with-index", false, "keep an in-memory index of the tasks, for faster queries on large stores")
 
 	runFlags            = flag.NewFlagSet("run", flag.ExitOnError)
 	runNoPull           = runFlags.Bool("no-pull", false, "don't pull providers (graph only)")
@@ -70,12 +107,38 @@ var (
 	runResync           = runFlags.Bool("resync", false, "resync already synced content")
 	runGitHubToken      = runFlags.String("github-token", "", "GitHub token")
 	runNoPert           = runFlags.Bool("no-pert", false, "disable PERT computing")
-	runFormat           = runFlags.String("format", "dot", "output format")
+	runDefaultEstimate  = runFlags.String("default-estimate", "1d", "PERT estimate of the tasks without one, e.g. 4h, 2d or 1w (0 to ignore them)")
+	runFormat           = runFlags.String("format", "dot", "output format (dot, mermaid, plantuml, d2, json, cytoscape, quads, graphman-pert, pert-report, cycles)")
+	runReportFormat     = runFlags.String("report-format", "text", "pert-report format (text, markdown, json)")
+	runQuadFormat       = runFlags.String("quad-format", "nquads", "quads format (nquads, pquads, json, jsonld, gml, graphml)")
 	runVertical         = runFlags.Bool("vertical", false, "vertical mode")
+	runClusterBy        = runFlags.String("cluster-by", "", "group the tasks by repo, milestone or assignee (dot format only)")
+	runTheme            = runFlags.String("theme", "", "YAML file of style rules for the tasks and dependencies of the dot, mermaid, plantuml, d2 and cytoscape formats (built-in theme by default, not applied by the web UI)")
 	runHidePRs          = runFlags.Bool("hide-prs", false, "hide PRs")
 	runHideExternalDeps = runFlags.Bool("hide-external-deps", false, "hide dependencies outside of the specified targets")
 	runHideIsolated     = runFlags.Bool("hide-isolated", false, "hide isolated tasks")
 	runShowClosed       = runFlags.Bool("show-closed", false, "show closed tasks")
+	runDepsDirection    = runFlags.String("deps-direction", "both", "follow the dependencies upstream, downstream or both")
+	runDepsDepth        = runFlags.Int("deps-depth", 1, "number of dependency hops to follow, -1 for the full transitive closure")
+	runFilter           = runFlags.String("filter", "", "only select the tasks matching a filter, e.g. 'label:backend -label:wontfix assignee:alice updated:>2026-01-01'")
+	runAsOf             = runFlags.String("as-of", "", "render the graph as it was at that date (YYYY-MM-DD or RFC 3339), from the snapshots")
+
+	diffFlags    = flag.NewFlagSet("diff", flag.ExitOnError)
+	diffFrom     = diffFlags.String("from", "", "old graph: a date (YYYY-MM-DD or RFC 3339), a dump-json or dump-quads file, a store URI or path")
+	diffTo       = diffFlags.String("to", "now", "new graph, same syntax as --from, 'now' is the current store")
+	diffFormat   = diffFlags.String("format", "text", "output format (text, json, dot)")
+	diffVertical = diffFlags.Bool("vertical", false, "vertical mode (dot)")
+
+	searchFlags      = flag.NewFlagSet("search", flag.ExitOnError)
+	searchLimit      = searchFlags.Int("limit", dvstore.DefaultSearchLimit, "maximum number of results, at most 100")
+	searchWithClosed = searchFlags.Bool("with-closed", false, "include closed tasks")
+	searchJSON       = searchFlags.Bool("json", false, "JSON output")
+
+	aliasListFlags    = flag.NewFlagSet("list", flag.ExitOnError)
+	aliasListJSON     = aliasListFlags.Bool("json", false, "JSON output")
+	aliasSuggestFlags = flag.NewFlagSet("suggest", flag.ExitOnError)
+	aliasSuggestApply = aliasSuggestFlags.Bool("apply", false, "declare every suggestion as an alias")
+	aliasSuggestJSON  = aliasSuggestFlags.Bool("json", false, "JSON output")
 )
 
 func main() {
@@ -116,11 +179,15 @@ func Main(args []string) error {
 				Name:      "store",
 				ShortHelp: "manage the data store",
 				Subcommands: []*ffcli.Command{
-					{Name: "dump-quads", Exec: execStoreDumpQuads},
-					{Name: "dump-json", Exec: execStoreDumpJSON},
-					{Name: "info", Exec: execStoreInfo},
-					// restore-quads
-					// restore-json
+					{Name: "dump-quads", Exec: execStoreDumpQuads, FlagSet: storeDumpQuadsFlags, ShortUsage: "dump-quads [flags] [path]", ShortHelp: "dump the store as quads"},
+					{Name: "dump-json", Exec: execStoreDumpJSON, ShortUsage: "dump-json [path]", ShortHelp: "dump the store as a JSON batch"},
+					{Name: "export", Exec: execStoreExport, FlagSet: storeExportFlags, ShortUsage: "export [flags] [path]", ShortHelp: "export the store for other tools (Gephi, yEd, JSON-LD)"},
+					{Name: "restore-quads", Exec: execStoreRestoreQuads, FlagSet: storeRestoreQuadsFlags, ShortUsage: "restore-quads [flags] [path]", ShortHelp: "restore quads into the store"},
+					{Name: "restore-json", Exec: execStoreRestoreJSON, FlagSet: storeRestoreJSONFlags, ShortUsage: "restore-json [flags] [path]", ShortHelp: "restore a JSON batch into the store"},
+					{Name: "info", Exec: execStoreInfo, FlagSet: storeInfoFlags, ShortHelp: "print store statistics"},
+					{Name: "migrate", Exec: execStoreMigrate, FlagSet: storeMigrateFlags, ShortHelp: "upgrade the store to the current schema version"},
+					{Name: "doctor", Exec: execStoreDoctor, FlagSet: storeDoctorFlags, ShortHelp: "check the store integrity and optionally repair it"},
+					{Name: "prune", Exec: execStorePrune, FlagSet: storePruneFlags, ShortUsage: "prune [flags] [target...]", ShortHelp: "drop old or unneeded entities from the store"},
 				},
 				Exec: func(context.Context, []string) error { return flag.ErrHelp },
 			}, {
@@ -129,6 +196,28 @@ func Main(args []string) error {
 				ShortUsage: "run [flags] [url...]",
 				Exec:       execRun,
 				FlagSet:    runFlags,
+			}, {
+				Name:       "diff",
+				ShortHelp:  "compare the graph between two dates, dumps or stores",
+				ShortUsage: "diff --from <date|dump|store> [--to <date|dump|store>] [flags] [url...]",
+				Exec:       execDiff,
+				FlagSet:    diffFlags,
+			}, {
+				Name:       "search",
+				ShortHelp:  "full-text search in the titles, descriptions, labels and authors of the tasks",
+				ShortUsage: "search [flags] <query...>",
+				Exec:       execSearch,
+				FlagSet:    searchFlags,
+			}, {
+				Name:      "alias",
+				ShortHelp: "declare that several users or tasks are the same entity",
+				Subcommands: []*ffcli.Command{
+					{Name: "add", Exec: execAliasAdd, ShortUsage: "add <canonical> <alias...>", ShortHelp: "declare aliases of a canonical entity"},
+					{Name: "rm", Exec: execAliasRemove, ShortUsage: "rm <alias...>", ShortHelp: "remove aliases"},
+					{Name: "list", Exec: execAliasList, FlagSet: aliasListFlags, ShortHelp: "list the aliases by canonical entity"},
+					{Name: "suggest", Exec: execAliasSuggest, FlagSet: aliasSuggestFlags, ShortHelp: "suggest aliases from matching emails, logins and transferred issues"},
+				},
+				Exec: func(context.Context, []string) error { return flag.ErrHelp },
 			}, {
 				Name:      "server",
 				ShortHelp: "start a depviz server with depviz API",
@@ -166,6 +255,11 @@ func globalPreRun() error {
 	logger.Debug("logger initialized")
 
 	schemaConfig = dvstore.Schema()
+
+	snapshotPolicy, err = dvstore.ParseSnapshotPolicy(*globalSnapshots)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -206,17 +300,115 @@ func execAirtableInfo(ctx context.Context, args []string) error {
 	return dvcore.AirtableInfo(opts)
 }
 
-func execStoreDumpQuads(ctx context.Context, args []string) error {
+func execSearch(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return flag.ErrHelp
+	}
+
+	store, err := storeFromArgs()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.SearchOpts{
+		Limit:      *searchLimit,
+		WithClosed: *searchWithClosed,
+		JSON:       *searchJSON,
+		Logger:     logger,
+		Schema:     schemaConfig,
+	}
+	return dvcore.Search(store, args, opts)
+}
+
+func execAliasAdd(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return flag.ErrHelp
+	}
+
+	store, err := storeFromArgs()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.AliasOpts{Logger: logger, Schema: schemaConfig}
+	return dvcore.AliasAdd(ctx, store, args, opts)
+}
+
+func execAliasRemove(ctx context.Context, args []string) error {
 	if err := globalPreRun(); err != nil {
 		return err
 	}
+	if len(args) == 0 {
+		return flag.ErrHelp
+	}
 
 	store, err := storeFromArgs()
 	if err != nil {
 		return fmt.Errorf("init store: %w", err)
 	}
 
-	return dvcore.StoreDumpQuads(store)
+	opts := dvcore.AliasOpts{Logger: logger, Schema: schemaConfig}
+	return dvcore.AliasRemove(ctx, store, args, opts)
+}
+
+func execAliasList(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	store, err := storeFromArgs()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.AliasOpts{
+		JSON:   *aliasListJSON,
+		Logger: logger,
+		Schema: schemaConfig,
+	}
+	return dvcore.AliasList(ctx, store, opts)
+}
+
+func execAliasSuggest(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	store, err := storeFromArgs()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.AliasOpts{
+		Apply:  *aliasSuggestApply,
+		JSON:   *aliasSuggestJSON,
+		Logger: logger,
+		Schema: schemaConfig,
+	}
+	return dvcore.AliasSuggest(ctx, store, opts)
+}
+
+func execStoreDumpQuads(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	store, err := storeFromArgsWithoutSchemaCheck() // raw access, no need to load entities
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.StoreDumpOpts{
+		Path:   pathFromArgs(args),
+		Format: *storeDumpQuadsFormat,
+	}
+	return dvcore.StoreDumpQuads(store, opts)
 }
 
 func execStoreDumpJSON(ctx context.Context, args []string) error {
@@ -229,13 +421,72 @@ func execStoreDumpJSON(ctx context.Context, args []string) error {
 		return fmt.Errorf("init store: %w", err)
 	}
 
-	batch, err := dvcore.GetStoreDump(ctx, store, schemaConfig)
+	opts := dvcore.StoreDumpOpts{
+		Path: pathFromArgs(args),
+	}
+	return dvcore.StoreDumpJSON(ctx, store, schemaConfig, opts)
+}
+
+func execStoreExport(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	store, err := storeFromArgs()
 	if err != nil {
-		return fmt.Errorf("get store dump: %w", err)
+		return fmt.Errorf("init store: %w", err)
 	}
 
-	fmt.Println(u.PrettyJSON(batch))
-	return nil
+	opts := dvcore.StoreExportOpts{
+		Path:   pathFromArgs(args),
+		Format: *storeExportFormat,
+	}
+	return dvcore.StoreExport(ctx, store, opts)
+}
+
+func execStoreRestoreQuads(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	replace, err := parseRestoreMode(*storeRestoreQuadsMode)
+	if err != nil {
+		return err
+	}
+
+	store, err := storeFromArgsWithoutSchemaCheck() // raw access, no need to load entities
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.StoreRestoreOpts{
+		Path:    pathFromArgs(args),
+		Format:  *storeRestoreQuadsFormat,
+		Replace: replace,
+	}
+	return dvcore.StoreRestoreQuads(store, opts)
+}
+
+func execStoreRestoreJSON(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	replace, err := parseRestoreMode(*storeRestoreJSONMode)
+	if err != nil {
+		return err
+	}
+
+	store, err := storeFromArgs()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.StoreRestoreOpts{
+		Path:    pathFromArgs(args),
+		Replace: replace,
+	}
+	return dvcore.StoreRestoreJSON(store, schemaConfig, opts)
 }
 
 func execStoreInfo(ctx context.Context, args []string) error {
@@ -243,18 +494,91 @@ func execStoreInfo(ctx context.Context, args []string) error {
 		return err
 	}
 
+	store, err := storeFromArgsWithoutSchemaCheck() // reports the schema version instead of failing
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	config, err := storeConfigFromArgs()
+	if err != nil {
+		return err
+	}
+
+	opts := dvcore.StoreInfoOpts{
+		Backend:  config.Backend,
+		Location: config.Location(),
+		JSON:     *storeInfoJSON,
+	}
+	return dvcore.StoreInfo(ctx, store, schemaConfig, opts)
+}
+
+func execStoreMigrate(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	store, err := storeFromArgsWithoutSchemaCheck()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.StoreMigrateOpts{
+		DryRun: *storeMigrateDryRun,
+		Logger: logger,
+	}
+	return dvcore.StoreMigrate(ctx, store, opts)
+}
+
+func execStoreDoctor(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
+	store, err := storeFromArgsWithoutSchemaCheck()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.StoreDoctorOpts{
+		Fix:    *storeDoctorFix,
+		Logger: logger,
+	}
+	return dvcore.StoreDoctor(ctx, store, opts)
+}
+
+func execStorePrune(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+
 	store, err := storeFromArgs()
 	if err != nil {
 		return fmt.Errorf("init store: %w", err)
 	}
 
-	return dvcore.StoreInfo(store)
+	targets, err := dvparser.ParseTargets(args)
+	if err != nil {
+		return fmt.Errorf("parse targets: %w", err)
+	}
+
+	opts := dvcore.StorePruneOpts{
+		ClosedOlderThan:     time.Duration(*storePruneClosedDays) * 24 * time.Hour,
+		UnconfiguredTargets: *storePruneUnconfigured,
+		Targets:             targets,
+		Orphans:             *storePruneOrphans,
+		DryRun:              *storePruneDryRun,
+		Logger:              logger,
+	}
+	return dvcore.StorePrune(ctx, store, opts)
 }
 
 func execRun(ctx context.Context, args []string) error {
 	if err := globalPreRun(); err != nil {
 		return err
 	}
+	if err := dvcore.CheckClusterBy(*runClusterBy, *runFormat); err != nil {
+		return fmt.Errorf("--cluster-by: %w", err)
+	}
 
 	store, err := storeFromArgs()
 	if err != nil {
@@ -269,16 +593,82 @@ func execRun(ctx context.Context, args []string) error {
 		NoGraph:          *runNoGraph,
 		NoPull:           *runNoPull,
 		Format:           *runFormat,
+		ReportFormat:     *runReportFormat,
+		ClusterBy:        *runClusterBy,
+		QuadFormat:       *runQuadFormat,
 		Resync:           *runResync,
+		Snapshots:        snapshotPolicy,
 		GitHubToken:      *runGitHubToken,
 		ShowClosed:       *runShowClosed,
 		HideIsolated:     *runHideIsolated,
 		HidePRs:          *runHidePRs,
 		HideExternalDeps: *runHideExternalDeps,
+		DepsDepth:        *runDepsDepth,
+	}
+	if *runDepsDepth == 0 {
+		return fmt.Errorf("--deps-depth: 0 follows no dependency, use --hide-external-deps instead")
+	}
+	opts.DepsDirection, err = dvstore.ParseDepsDirection(*runDepsDirection)
+	if err != nil {
+		return err
+	}
+	defaultEstimate, err := dvmodel.ParseEstimate(*runDefaultEstimate)
+	if err != nil {
+		return fmt.Errorf("--default-estimate: %w", err)
+	}
+	if len(defaultEstimate) != 1 {
+		return fmt.Errorf("--default-estimate: expected a single duration, got %q", *runDefaultEstimate)
+	}
+	opts.DefaultEstimate = defaultEstimate[0]
+	if opts.DefaultEstimate == 0 { // the zero value of RunOpts.DefaultEstimate is dvcore.DefaultEstimate
+		opts.DefaultEstimate = dvcore.NoDefaultEstimate
+	}
+	opts.Filter, err = dvstore.ParseTaskFilter(*runFilter)
+	if err != nil {
+		return err
+	}
+	opts.Theme, err = dvcore.LoadTheme(*runTheme)
+	if err != nil {
+		return fmt.Errorf("--theme: %w", err)
+	}
+	if *runAsOf != "" {
+		opts.AsOf, err = dvparser.ParseAsOf(*runAsOf)
+		if err != nil {
+			return err
+		}
 	}
 	return dvcore.Run(store, args, opts)
 }
 
+func execDiff(ctx context.Context, args []string) error {
+	if err := globalPreRun(); err != nil {
+		return err
+	}
+	if *diffFrom == "" {
+		return flag.ErrHelp
+	}
+
+	config, err := storeConfigFromArgs()
+	if err != nil {
+		return err
+	}
+	store, err := storeFromArgs()
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+
+	opts := dvcore.DiffOpts{
+		From:     *diffFrom,
+		To:       *diffTo,
+		Format:   *diffFormat,
+		Vertical: *diffVertical,
+		Logger:   logger,
+		Schema:   schemaConfig,
+		Store:    config,
+	}
+	return dvcore.Diff(store, args, opts)
+}
+
 func execServer(ctx context.Context, args []string) error {
 	if err := globalPreRun(); err != nil {
 		return err
@@ -300,6 +690,11 @@ func execServer(ctx context.Context, args []string) error {
 			return fmt.Errorf("parse targets: %w", err)
 		}
 
+		storeConfig, err := storeConfigFromArgs()
+		if err != nil {
+			return err
+		}
+
 		opts := dvserver.Opts{
 			Logger:             logger,
 			HTTPBind:           *serverHTTPBind,
@@ -319,6 +714,17 @@ func execServer(ctx context.Context, args []string) error {
 			AutoUpdateInterval: *serverAutoUpdateInterval,
 			GitHubClientID:     *serverGitHubClientID,
 			GitHubClientSecret: *serverGitHubClientSecret,
+			StoreBackend:       storeConfig.Backend,
+			StoreLocation:      storeConfig.Location(),
+			Snapshots:          snapshotPolicy,
+			PruneInterval:      *serverPruneInterval,
+			PruneOpts: dvstore.PruneOpts{
+				ClosedOlderThan:     time.Duration(*serverPruneClosedDays) * 24 * time.Hour,
+				UnconfiguredTargets: *serverPruneUnconfigured,
+				Targets:             targets,
+				Orphans:             *serverPruneOrphans,
+			},
+			WithIndex: *serverWithIndex,
 		}
 		svc, err = dvserver.New(ctx, store, schemaConfig, opts)
 		if err != nil {
@@ -358,16 +764,58 @@ func execServer(ctx context.Context, args []string) error {
 	return nil
 }
 
+func storeConfigFromArgs() (dvstore.StoreConfig, error) {
+	uri := *globalStoreURI
+	if uri == "" {
+		uri = *globalStorePath
+	}
+	config, err := dvstore.ParseStoreURI(uri)
+	if err != nil {
+		return dvstore.StoreConfig{}, fmt.Errorf("parse store URI: %w", err)
+	}
+	return config, nil
+}
+
 func storeFromArgs() (*cayley.Handle, error) {
-	if _, err := os.Stat(*globalStorePath); err != nil {
-		if err := graph.InitQuadStore("bolt", *globalStorePath, nil); err != nil {
-			return nil, fmt.Errorf("create quad store: %w", err)
-		}
+	store, err := storeFromArgsWithoutSchemaCheck()
+	if err != nil {
+		return nil, err
 	}
-	store, err := cayley.NewGraph("bolt", *globalStorePath, nil)
+	if err := dvstore.CheckSchemaVersion(context.Background(), store); err != nil {
+		_ = store.Close()
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func storeFromArgsWithoutSchemaCheck() (*cayley.Handle, error) {
+	config, err := storeConfigFromArgs()
+	if err != nil {
+		return nil, err
+	}
+	store, err := dvstore.OpenStore(config)
 	if err != nil {
-		return nil, fmt.Errorf("load STORE: %w", err)
+		return nil, fmt.Errorf("open %s store: %w", config.Backend, err)
 	}
 
 	return store, nil
 }
+
+func pathFromArgs(args []string) string {
+	if len(args) < 1 {
+		return ""
+	}
+	return args[0]
+}
+
+func parseRestoreMode(mode string) (bool, error) {
+	switch mode {
+	case "merge":
+		return false, nil
+	case "replace":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported restore mode: %q", mode)
+	}
+}
@@ -0,0 +1,167 @@
+package dvcore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvparser"
+	"moul.io/depviz/v3/internal/dvstore"
+)
+
+type AliasOpts struct {
+	Apply  bool
+	JSON   bool
+	Logger *zap.Logger
+	Schema *schema.Config
+}
+
+// AliasAdd declares the entities of args[1:] as aliases of the entity of args[0].
+func AliasAdd(ctx context.Context, h *cayley.Handle, args []string, opts AliasOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("expected a canonical entity and at least one alias")
+	}
+	iris, err := aliasIRIs(args)
+	if err != nil {
+		return err
+	}
+	for _, alias := range iris[1:] {
+		if err := dvstore.AddAlias(ctx, h, iris[0], alias); err != nil {
+			return fmt.Errorf("add alias %s: %w", string(alias), err)
+		}
+		opts.Logger.Debug("alias added", zap.String("canonical", string(iris[0])), zap.String("alias", string(alias)))
+		fmt.Printf("%s -> %s\n", string(alias), string(iris[0]))
+	}
+	return nil
+}
+
+// AliasRemove removes the aliases of args, which become distinct entities again.
+func AliasRemove(ctx context.Context, h *cayley.Handle, args []string, opts AliasOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	iris, err := aliasIRIs(args)
+	if err != nil {
+		return err
+	}
+	for _, alias := range iris {
+		if err := dvstore.RemoveAlias(ctx, h, alias); err != nil {
+			return fmt.Errorf("remove alias: %w", err)
+		}
+		opts.Logger.Debug("alias removed", zap.String("alias", string(alias)))
+	}
+	return nil
+}
+
+// AliasList prints the aliases of the store, grouped by canonical entity.
+func AliasList(ctx context.Context, h *cayley.Handle, opts AliasOpts) error {
+	aliases, err := dvstore.LoadAliases(ctx, h)
+	if err != nil {
+		return err
+	}
+	groups := map[quad.IRI][]quad.IRI{}
+	for alias := range aliases {
+		canonical := aliases.Canonical(alias)
+		groups[canonical] = append(groups[canonical], alias)
+	}
+	for _, members := range groups {
+		sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+	}
+
+	if opts.JSON {
+		out, err := json.MarshalIndent(groups, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	printAliases(os.Stdout, groups)
+	return nil
+}
+
+// AliasSuggest prints the entities that look like the same one, and declares them as aliases with opts.Apply.
+func AliasSuggest(ctx context.Context, h *cayley.Handle, opts AliasOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	suggestions, err := dvstore.SuggestAliases(ctx, h, opts.Schema)
+	if err != nil {
+		return fmt.Errorf("suggest aliases: %w", err)
+	}
+	opts.Logger.Debug("aliases suggested", zap.Int("suggestions", len(suggestions)))
+
+	if opts.Apply {
+		for _, suggestion := range suggestions {
+			if err := dvstore.AddAlias(ctx, h, suggestion.Canonical, suggestion.Alias); err != nil {
+				return fmt.Errorf("add alias %s: %w", string(suggestion.Alias), err)
+			}
+		}
+	}
+
+	if opts.JSON {
+		out, err := json.MarshalIndent(suggestions, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	if len(suggestions) == 0 {
+		fmt.Println("no suggestions")
+		return nil
+	}
+	for _, suggestion := range suggestions {
+		fmt.Printf("%s -> %s  (%s)\n", string(suggestion.Alias), string(suggestion.Canonical), suggestion.Reason)
+	}
+	if opts.Apply {
+		fmt.Printf("added %d aliases\n", len(suggestions))
+	}
+	return nil
+}
+
+func printAliases(w io.Writer, groups map[quad.IRI][]quad.IRI) {
+	if len(groups) == 0 {
+		fmt.Fprintln(w, "no aliases")
+		return
+	}
+	canonicals := make([]quad.IRI, 0, len(groups))
+	for canonical := range groups {
+		canonicals = append(canonicals, canonical)
+	}
+	sort.Slice(canonicals, func(i, j int) bool { return canonicals[i] < canonicals[j] })
+	for _, canonical := range canonicals {
+		fmt.Fprintln(w, string(canonical))
+		for _, alias := range groups[canonical] {
+			fmt.Fprintf(w, "  %s\n", string(alias))
+		}
+	}
+}
+
+// aliasIRIs converts args to IRIs, either targets like moul/depviz#1 and @moul, or absolute IRIs of other providers.
+func aliasIRIs(args []string) ([]quad.IRI, error) {
+	iris := make([]quad.IRI, 0, len(args))
+	for _, arg := range args {
+		entity, err := dvparser.ParseTarget(arg)
+		switch {
+		case err == nil:
+			iris = append(iris, quad.IRI(entity.String()))
+		case strings.Contains(arg, "://"):
+			iris = append(iris, quad.IRI(arg))
+		default:
+			return nil, fmt.Errorf("parse %q: %w", arg, err)
+		}
+	}
+	return iris, nil
+}
@@ -0,0 +1,71 @@
+package dvcore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvstore"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestAliasMergesTasks(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := dvstore.TestingStore(t)
+	defer close()
+
+	var (
+		current  = quad.IRI("https://github.com/acme/new/issues/7")
+		previous = quad.IRI("https://github.com/acme/old/issues/1")
+		dep      = quad.IRI("https://github.com/acme/new/issues/8")
+		other    = quad.IRI("https://github.com/acme/new/issues/9")
+		child    = quad.IRI("https://github.com/acme/old/issues/2")
+		blocked  = quad.IRI("https://github.com/acme/new/issues/10")
+		bug      = quad.IRI("https://github.com/acme/new/labels/bug")
+		urgent   = quad.IRI("https://github.com/acme/old/labels/urgent")
+		alice    = quad.IRI("https://github.com/alice")
+	)
+	batch := dvmodel.Batch{Tasks: []*dvmodel.Task{
+		{ID: current, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, Title: "current", HasLabel: []quad.IRI{bug}, IsDependingOn: []quad.IRI{dep}},
+		{ID: previous, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, Title: "previous", HasLabel: []quad.IRI{urgent, bug}, HasAssignee: []quad.IRI{alice}, IsDependingOn: []quad.IRI{other, current}, HasPart: []quad.IRI{child}},
+		{ID: dep, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open},
+		{ID: other, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open},
+		{ID: child, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open},
+		{ID: blocked, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{previous}},
+	}}
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{batch}, dvstore.SnapshotNone))
+	require.NoError(t, AliasAdd(ctx, store, []string{"acme/new#7", "acme/old#1"}, AliasOpts{Logger: logger}))
+
+	index, err := dvstore.NewIndex(ctx, store, schemaConfig)
+	require.NoError(t, err)
+	for _, filters := range []dvstore.LoadTasksFilters{
+		{TheWorld: true},
+		{TheWorld: true, Index: index},
+	} {
+		tasks, err := dvstore.LoadTasks(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		found := map[quad.IRI]dvmodel.Task{}
+		for _, task := range tasks {
+			found[task.ID] = task
+		}
+		require.Contains(t, found, current)
+		assert.NotContains(t, found, previous)
+
+		// the alias' fields are merged into the canonical task, without duplicates nor references to itself
+		merged := found[current]
+		assert.Equal(t, "current", merged.Title)
+		assert.ElementsMatch(t, []quad.IRI{bug, urgent}, merged.HasLabel)
+		assert.Equal(t, []quad.IRI{alice}, merged.HasAssignee)
+		assert.ElementsMatch(t, []quad.IRI{dep, other}, merged.IsDependingOn)
+		assert.Equal(t, []quad.IRI{child}, merged.HasPart)
+		assert.NotContains(t, merged.IsBlocking, previous)
+
+		// the relationships pointing at the alias point at the canonical task
+		assert.Equal(t, []quad.IRI{current}, found[blocked].IsDependingOn)
+		assert.Equal(t, []quad.IRI{current}, found[child].IsPartOf)
+	}
+}
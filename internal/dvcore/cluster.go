@@ -0,0 +1,109 @@
+package dvcore
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// clusterColors are the colors of the DOT clusters, picked in turn.
+var clusterColors = []string{"lightblue", "lightgoldenrod", "palegreen", "pink", "lavender", "peachpuff", "lightcyan", "thistle"}
+
+// CheckClusterBy returns an error if clusterBy is not a supported grouping, see clusterKey,
+// or if it is set for another format than dot, the only one with clusters.
+func CheckClusterBy(clusterBy, format string) error {
+	if clusterBy == "" {
+		return nil
+	}
+	if _, err := clusterKey(dvmodel.Task{}, clusterBy); err != nil {
+		return err
+	}
+	if format != "dot" {
+		return fmt.Errorf("cluster-by is only supported by the dot format, not %q", format)
+	}
+	return nil
+}
+
+// clusterKey returns the group of a task for clusterBy (repo, milestone or assignee), or "" if it has none.
+func clusterKey(task dvmodel.Task, clusterBy string) (quad.IRI, error) {
+	switch clusterBy {
+	case "repo":
+		return task.HasOwner, nil
+	case "milestone":
+		if task.Kind == dvmodel.Task_Milestone {
+			return task.ID, nil
+		}
+		return task.HasMilestone, nil
+	case "assignee":
+		if len(task.HasAssignee) > 0 {
+			return task.HasAssignee[0], nil
+		}
+		return "", nil
+	default:
+		return "", fmt.Errorf("unsupported cluster-by: %q (repo, milestone, assignee)", clusterBy)
+	}
+}
+
+// cluster wraps the task nodes into one `subgraph cluster_*` per repo, milestone or assignee.
+//
+// Tasks without a group stay in the main graph.
+func (g *dotGraph) cluster(tasks dvmodel.Tasks, clusterBy string) error {
+	groups := map[quad.IRI][]quad.IRI{}
+	for _, task := range tasks {
+		key, err := clusterKey(task, clusterBy)
+		if err != nil {
+			return err
+		}
+		if key != "" {
+			groups[key] = append(groups[key], task.ID)
+		}
+	}
+	keys := make([]quad.IRI, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	for i, key := range keys {
+		name := fmt.Sprintf("cluster_%d", i)
+		attrs := map[string]string{
+			"label":     strconv.Quote(clusterLabel(tasks, key)),
+			"style":     "\"filled,rounded\"",
+			"color":     clusterColors[i%len(clusterColors)],
+			"fillcolor": clusterColors[i%len(clusterColors)],
+		}
+		if err := g.AddSubGraph(g.Name, name, attrs); err != nil {
+			return err
+		}
+		for _, id := range groups[key] {
+			node, found := g.nodes[id]
+			if !found { // e.g., not part of the simplified PERT graph
+				continue
+			}
+			g.Relations.Remove(g.Name, node)
+			g.Relations.Add(name, node)
+		}
+	}
+	return nil
+}
+
+// clusterLabel returns the local ID of a group, or its IRI without the scheme and host if it is not a loaded task.
+func clusterLabel(tasks dvmodel.Tasks, key quad.IRI) string {
+	for _, task := range tasks {
+		if task.ID == key && task.LocalID != "" {
+			return task.LocalID
+		}
+	}
+	label := string(key)
+	if i := strings.Index(label, "://"); i >= 0 {
+		label = label[i+3:]
+		if j := strings.Index(label, "/"); j >= 0 && j < len(label)-1 {
+			label = label[j+1:]
+		}
+	}
+	return label
+}
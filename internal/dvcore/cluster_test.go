@@ -0,0 +1,65 @@
+package dvcore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/graphman"
+	"moul.io/graphman/viz"
+)
+
+func TestClusterDot(t *testing.T) {
+	tasks := dvmodel.Tasks{
+		{ID: "https://github.com/a/b", LocalID: "a/b", Kind: dvmodel.Task_Issue},
+		{ID: "https://github.com/a/b/issues/1", Kind: dvmodel.Task_Issue, HasOwner: "https://github.com/a/b", HasAssignee: []quad.IRI{"https://github.com/alice"}},
+		{ID: "https://github.com/a/b/issues/2", Kind: dvmodel.Task_Issue, HasOwner: "https://github.com/a/b", IsDependingOn: []quad.IRI{"https://github.com/a/b/issues/1"}},
+		{ID: "https://github.com/c/d/issues/3", Kind: dvmodel.Task_Issue, HasOwner: "https://github.com/c/d", IsDependingOn: []quad.IRI{"https://github.com/a/b/issues/2"}},
+	}
+	graph := graphman.FromPertConfig(*graphmanPertConfig(tasks, RunOpts{Logger: zap.NewNop()}))
+	dot, err := viz.ToGraphviz(graph, nil)
+	require.NoError(t, err)
+
+	cluster := func(clusterBy string) (string, error) {
+		graph, err := parseDot(dot)
+		require.NoError(t, err)
+		if err := graph.cluster(tasks, clusterBy); err != nil {
+			return "", err
+		}
+		return graph.String(), nil
+	}
+
+	clustered, err := cluster("repo")
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(clustered, "subgraph cluster_"))
+	assert.Contains(t, clustered, `label="a/b";`)
+	assert.Contains(t, clustered, `label="c/d";`)
+	assert.Equal(t, strings.Count(dot, "->"), strings.Count(clustered, "->"))
+
+	clustered, err = cluster("assignee")
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(clustered, "subgraph cluster_"))
+	assert.Contains(t, clustered, `label="alice";`)
+
+	clustered, err = cluster("milestone")
+	require.NoError(t, err)
+	assert.NotContains(t, clustered, "subgraph")
+
+	_, err = cluster("foo")
+	assert.Error(t, err)
+}
+
+func TestCheckClusterBy(t *testing.T) {
+	assert.NoError(t, CheckClusterBy("", "json"))
+	assert.NoError(t, CheckClusterBy("repo", "dot"))
+	assert.Error(t, CheckClusterBy("foo", "dot"))
+	assert.Error(t, CheckClusterBy("milestone", "mermaid"))
+
+	// checked before pulling and loading anything
+	err := Run(nil, []string{"moul/depviz"}, RunOpts{NoPull: true, Format: "json", ClusterBy: "repo"})
+	assert.EqualError(t, err, `cluster-by is only supported by the dot format, not "json"`)
+}
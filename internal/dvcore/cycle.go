@@ -0,0 +1,76 @@
+package dvcore
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/graphman"
+)
+
+// cycleColor is the color of the dependencies that are part of a cycle, see highlightCycles.
+const cycleColor = "orange"
+
+// warnCycles logs each dependency cycle, PERT results are not reliable with cycles.
+func warnCycles(logger *zap.Logger, cycles []*dvmodel.Cycle) {
+	for _, cycle := range cycles {
+		logger.Warn("dependency cycle, PERT results are not reliable", zap.Any("path", cycle.Path))
+	}
+}
+
+// printCycles writes a report of each cycle, with the links of the tasks.
+func printCycles(w io.Writer, tasks dvmodel.Tasks, cycles []*dvmodel.Cycle) {
+	if len(cycles) == 0 {
+		fmt.Fprintln(w, "no dependency cycles")
+		return
+	}
+	byID := map[quad.IRI]dvmodel.Task{}
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+	label := func(id quad.IRI) string {
+		if task := byID[id]; task.LocalID != "" {
+			return task.LocalID
+		}
+		return string(id)
+	}
+
+	fmt.Fprintf(w, "%d dependency cycles (a -> b: a depends on b)\n", len(cycles))
+	for i, cycle := range cycles {
+		path := []string{}
+		for _, id := range append(cycle.Path, cycle.Path[0]) {
+			path = append(path, label(id))
+		}
+		fmt.Fprintf(w, "\ncycle %d: %s\n", i+1, strings.Join(path, " -> "))
+		for _, id := range cycle.Tasks {
+			fmt.Fprintf(w, "  %s  %s  %s\n", label(id), string(id), byID[id].Title)
+		}
+		if len(cycle.Dependencies) > len(cycle.Path) {
+			fmt.Fprintln(w, "  dependencies:")
+			for _, dep := range cycle.Dependencies {
+				fmt.Fprintf(w, "    %s -> %s\n", label(dep.Task), label(dep.DependsOn))
+			}
+		}
+	}
+}
+
+// highlightCycles colors the edges of a PERT graph that are dependencies of a cycle.
+//
+// An action depending on another one is the destination of an edge from the other one, see graphman.FromPertConfig.
+func highlightCycles(graph *graphman.Graph, cycles []*dvmodel.Cycle) {
+	inCycle := map[[2]string]bool{}
+	for _, cycle := range cycles {
+		for _, dep := range cycle.Dependencies {
+			inCycle[[2]string{string(dep.DependsOn), string(dep.Task)}] = true
+		}
+	}
+	for _, edge := range graph.Edges() {
+		if inCycle[[2]string{edge.Src().ID(), edge.Dst().ID()}] {
+			edge.SetColor(cycleColor)
+			edge.Attrs["style"] = "bold"
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package dvcore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	yaml "gopkg.in/yaml.v2"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvstore"
+	"moul.io/depviz/v3/internal/testutil"
+	"moul.io/graphman"
+)
+
+func TestCycles(t *testing.T) {
+	tasks := dvmodel.Tasks{
+		{ID: "https://example.com/1", LocalID: "example#1", Kind: dvmodel.Task_Issue, Title: "first", IsDependingOn: []quad.IRI{"https://example.com/2"}},
+		{ID: "https://example.com/2", LocalID: "example#2", Kind: dvmodel.Task_Issue, Title: "second", IsDependingOn: []quad.IRI{"https://example.com/1"}},
+		{ID: "https://example.com/3", LocalID: "example#3", Kind: dvmodel.Task_Issue, IsDependingOn: []quad.IRI{"https://example.com/2"}},
+	}
+	cycles := tasks.Cycles()
+
+	var out strings.Builder
+	printCycles(&out, tasks, cycles)
+	assert.Equal(t, `1 dependency cycles (a -> b: a depends on b)
+
+cycle 1: example#1 -> example#2 -> example#1
+  example#1  https://example.com/1  first
+  example#2  https://example.com/2  second
+`, out.String())
+	out.Reset()
+	printCycles(&out, tasks[2:], tasks[2:].Cycles())
+	assert.Equal(t, "no dependency cycles\n", out.String())
+
+	config := graphmanPertConfig(tasks, RunOpts{Logger: zap.NewNop()})
+	graph := graphman.FromPertConfig(*config)
+	highlightCycles(graph, cycles)
+	highlighted := []string{}
+	for _, edge := range graph.Edges() {
+		if edge.GetColor() == cycleColor {
+			highlighted = append(highlighted, edge.Src().ID()+" "+edge.Dst().ID())
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		"https://example.com/1 https://example.com/2",
+		"https://example.com/2 https://example.com/1",
+	}, highlighted)
+
+	report := pertReport(config, tasks)
+	assert.Equal(t, cycles, report.Cycles)
+	out.Reset()
+	assert.NoError(t, writePertReport(&out, report, "markdown"))
+	assert.Contains(t, out.String(), "1. [example#1](https://example.com/1) → [example#2](https://example.com/2) → [example#1](https://example.com/1)")
+
+	// the cycles are listed next to the PERT config, which graphman still loads
+	out.Reset()
+	assert.NoError(t, writePertConfig(&out, config, cycles))
+	assert.Contains(t, out.String(), "cycles:\n- - https://example.com/1\n  - https://example.com/2\n")
+	var loaded graphman.PertConfig
+	assert.NoError(t, yaml.Unmarshal([]byte(out.String()), &loaded))
+	assert.Equal(t, *config, loaded)
+}
+
+func TestWriteTasksJSON(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := dvstore.TestingStore(t)
+	defer close()
+
+	batch := dvmodel.Batch{Tasks: []*dvmodel.Task{
+		{ID: "https://github.com/acme/repo/issues/1", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{"https://github.com/acme/repo/issues/2"}},
+		{ID: "https://github.com/acme/repo/issues/2", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{"https://github.com/acme/repo/issues/1"}},
+		{ID: "https://github.com/acme/repo/issues/3", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open},
+	}}
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{batch}, dvstore.SnapshotNone))
+
+	write := func(filters dvstore.LoadTasksFilters) (dvmodel.Tasks, []*dvmodel.Cycle) {
+		it, err := dvstore.IterateTasks(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, writeTasksJSON(&out, it))
+		var decoded struct {
+			Tasks  dvmodel.Tasks    `json:"tasks"`
+			Cycles []*dvmodel.Cycle `json:"cycles"`
+		}
+		require.NoError(t, json.Unmarshal(out.Bytes(), &decoded), out.String())
+		return decoded.Tasks, decoded.Cycles
+	}
+	tasks, cycles := write(dvstore.LoadTasksFilters{TheWorld: true})
+	assert.Len(t, tasks, 3)
+	require.Len(t, cycles, 1)
+	assert.Equal(t, []quad.IRI{"https://github.com/acme/repo/issues/1", "https://github.com/acme/repo/issues/2"}, cycles[0].Path)
+
+	filter, err := dvstore.ParseTaskFilter("-kind:issue")
+	require.NoError(t, err)
+	tasks, cycles = write(dvstore.LoadTasksFilters{TheWorld: true, Filter: filter})
+	assert.Empty(t, tasks)
+	assert.Empty(t, cycles)
+}
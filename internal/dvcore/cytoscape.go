@@ -0,0 +1,192 @@
+package dvcore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// cytoscapeGraph is the elements JSON of Cytoscape.js, i.e. the result of cy.json().
+//
+// The nodes and edges are the ones built by the web visualizer (web/src/ui/Visualizer/index.js) for its Cytoscape
+// renderer, without the ghost nodes: relationships with tasks that are not loaded are dropped. The owners of the tasks
+// are compound nodes, the parents of the tasks without a loaded milestone, which the web visualizer does not create.
+// The theme styles of the tasks and dependencies are in their data.style, for the stylesheets of the consumers.
+type cytoscapeGraph struct {
+	Elements struct {
+		Nodes []cytoscapeElement `json:"nodes"`
+		Edges []cytoscapeElement `json:"edges"`
+	} `json:"elements"`
+}
+
+type cytoscapeElement struct {
+	Data    map[string]interface{} `json:"data"`
+	Classes string                 `json:"classes,omitempty"`
+}
+
+func newCytoscapeGraph(tasks dvmodel.Tasks, cycles []*dvmodel.Cycle, owners map[quad.IRI]dvmodel.Owner, theme *Theme, topics map[quad.IRI]dvmodel.Topic, now time.Time) (*cytoscapeGraph, error) {
+	loaded := map[quad.IRI]*dvmodel.Task{}
+	for i := range tasks {
+		loaded[tasks[i].ID] = &tasks[i]
+	}
+	inCycle := map[[2]quad.IRI]bool{}
+	for _, cycle := range cycles {
+		for _, dep := range cycle.Dependencies {
+			inCycle[[2]quad.IRI{dep.Task, dep.DependsOn}] = true
+		}
+	}
+
+	graph := cytoscapeGraph{}
+	graph.Elements.Nodes = []cytoscapeElement{}
+	graph.Elements.Edges = []cytoscapeElement{}
+	edges := map[string]bool{}
+	addEdge := func(source, target quad.IRI, relation string) {
+		if loaded[source] == nil || loaded[target] == nil {
+			return
+		}
+		id := fmt.Sprintf("edge_%s_%s_%s", relation, string(source), string(target))
+		if edges[id] {
+			return
+		}
+		edges[id] = true
+		edge := cytoscapeElement{Data: map[string]interface{}{
+			"id":       id,
+			"source":   string(source),
+			"target":   string(target),
+			"relation": relation,
+		}}
+		if relation == "is_depending_on" {
+			if inCycle[[2]quad.IRI{source, target}] {
+				edge.Classes = "cycle"
+			}
+			if style := theme.edgeStyle(loaded[target], loaded[source]); style != (Style{}) {
+				edge.Data["style"] = style
+			}
+		}
+		graph.Elements.Edges = append(graph.Elements.Edges, edge)
+	}
+
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	toData := func(entity proto.Message, id quad.IRI) (map[string]interface{}, error) {
+		out, err := marshaler.MarshalToString(entity)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s: %w", string(id), err)
+		}
+		data := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(out), &data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	// owners first, as the parents of the tasks
+	parents := map[quad.IRI]bool{}
+	ownerIDs := []quad.IRI{}
+	for _, task := range tasks {
+		if _, found := owners[task.HasOwner]; found && !parents[task.HasOwner] {
+			parents[task.HasOwner] = true
+			ownerIDs = append(ownerIDs, task.HasOwner)
+		}
+	}
+	sort.Slice(ownerIDs, func(i, j int) bool { return ownerIDs[i] < ownerIDs[j] })
+	for _, id := range ownerIDs {
+		owner := owners[id]
+		data, err := toData(&owner, id)
+		if err != nil {
+			return nil, err
+		}
+		graph.Elements.Nodes = append(graph.Elements.Nodes, cytoscapeElement{Data: data, Classes: "Owner"})
+	}
+
+	for i := range tasks {
+		task := &tasks[i]
+		data, err := toData(task, task.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// same fields as the web visualizer
+		cardClasses := "open"
+		switch {
+		case task.State == dvmodel.Task_Closed:
+			cardClasses = "closed"
+		case task.Kind == dvmodel.Task_MergeRequest:
+			cardClasses = "in-progress"
+		}
+		switch task.Kind { // nolint:exhaustive
+		case dvmodel.Task_Issue:
+			data["bgcolor"], data["is_issue"], data["progress"] = "lightblue", true, 0.5
+			cardClasses += " issue"
+		case dvmodel.Task_Milestone:
+			data["bgcolor"], data["is_milestone"] = "lightgreen", true
+			cardClasses += " milestone"
+		case dvmodel.Task_MergeRequest:
+			data["bgcolor"], data["is_mergerequest"] = "purple", true
+			cardClasses += " pr"
+		default:
+			data["bgcolor"], data["is_issue"], data["progress"] = "grey", true, 0
+			cardClasses += " ghost"
+		}
+		data["card_classes"] = cardClasses
+		data["html_id"] = cytoscapeHTMLID(task.ID)
+		data["nb_parents"] = len(task.IsBlocking) + len(task.IsPartOf)
+		data["nb_children"] = len(task.IsDependingOn) + len(task.HasPart)
+		data["nb_related"] = len(task.IsRelatedWith)
+		switch {
+		case loaded[task.HasMilestone] != nil:
+			data["parent"] = string(task.HasMilestone)
+		case parents[task.HasOwner]:
+			data["parent"] = string(task.HasOwner)
+		}
+		if style := theme.nodeStyle(*task, topics, now); style != (Style{}) {
+			data["style"] = style
+		}
+		graph.Elements.Nodes = append(graph.Elements.Nodes, cytoscapeElement{Data: data, Classes: task.Kind.String()})
+
+		// relationships
+		for _, other := range task.IsDependingOn {
+			addEdge(task.ID, other, "is_depending_on")
+		}
+		for _, other := range task.IsBlocking {
+			addEdge(other, task.ID, "is_depending_on")
+		}
+		for _, other := range task.IsRelatedWith {
+			addEdge(other, task.ID, "related_with")
+		}
+		for _, other := range task.IsPartOf {
+			addEdge(task.ID, other, "part_of")
+		}
+		for _, other := range task.HasPart {
+			addEdge(other, task.ID, "part_of")
+		}
+	}
+	return &graph, nil
+}
+
+// cytoscapeHTMLID returns the DOM identifier of a task card, as computed by the web visualizer.
+func cytoscapeHTMLID(id quad.IRI) string {
+	htmlID := strings.TrimPrefix(string(id), "https://github.com/")
+	htmlID = strings.Replace(htmlID, "/issues/", "", 1)
+	return strings.NewReplacer("/", "_", "#", "_").Replace(htmlID)
+}
+
+func writeCytoscape(w io.Writer, tasks dvmodel.Tasks, cycles []*dvmodel.Cycle, owners map[quad.IRI]dvmodel.Owner, theme *Theme, topics map[quad.IRI]dvmodel.Topic, now time.Time) error {
+	graph, err := newCytoscapeGraph(tasks, cycles, owners, theme, topics, now)
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
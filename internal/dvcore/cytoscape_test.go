@@ -0,0 +1,102 @@
+package dvcore
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+func TestCytoscape(t *testing.T) {
+	tasks := dvmodel.Tasks{
+		{ID: "https://github.com/a/b/issues/1", LocalID: "a/b#1", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, HasMilestone: "https://github.com/a/b/milestone/1", IsDependingOn: []quad.IRI{"https://github.com/a/b/pull/2", "https://github.com/c/d/issues/3"}},
+		{ID: "https://github.com/a/b/pull/2", LocalID: "a/b#2", Kind: dvmodel.Task_MergeRequest, State: dvmodel.Task_Open, IsBlocking: []quad.IRI{"https://github.com/a/b/issues/1"}, IsDependingOn: []quad.IRI{"https://github.com/a/b/issues/1"}},
+		{ID: "https://github.com/a/b/milestone/1", Kind: dvmodel.Task_Milestone, State: dvmodel.Task_Closed},
+	}
+
+	var out strings.Builder
+	require.NoError(t, writeCytoscape(&out, tasks, tasks.Cycles(), nil, DefaultTheme(), nil, time.Now()))
+	var graph struct {
+		Elements struct {
+			Nodes []struct {
+				Data    map[string]interface{}
+				Classes string
+			}
+			Edges []struct {
+				Data    map[string]interface{}
+				Classes string
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &graph))
+
+	require.Len(t, graph.Elements.Nodes, 3)
+	issue := graph.Elements.Nodes[0]
+	assert.Equal(t, "Issue", issue.Classes)
+	assert.Equal(t, "Issue", issue.Data["kind"])
+	assert.Equal(t, "Open", issue.Data["state"])
+	assert.Equal(t, "a/b#1", issue.Data["local_id"])
+	assert.Equal(t, "open issue", issue.Data["card_classes"])
+	assert.Equal(t, "a_b1", issue.Data["html_id"])
+	assert.Equal(t, "https://github.com/a/b/milestone/1", issue.Data["parent"])
+	assert.EqualValues(t, 2, issue.Data["nb_children"])
+	assert.Equal(t, "in-progress pr", graph.Elements.Nodes[1].Data["card_classes"])
+	assert.Equal(t, "closed milestone", graph.Elements.Nodes[2].Data["card_classes"])
+
+	// the theme styles, without the unresolved label colors
+	assert.NotContains(t, issue.Data, "style")
+	assert.Equal(t, map[string]interface{}{"style": "dashed"}, graph.Elements.Nodes[1].Data["style"])
+	assert.Equal(t, map[string]interface{}{"color": "gray", "fontcolor": "gray"}, graph.Elements.Nodes[2].Data["style"])
+
+	// the duplicated dependency is merged, the one on c/d#3 is dropped
+	require.Len(t, graph.Elements.Edges, 2)
+	for _, edge := range graph.Elements.Edges {
+		assert.Equal(t, "is_depending_on", edge.Data["relation"])
+		assert.Equal(t, "cycle", edge.Classes)
+		assert.Equal(t, "edge_is_depending_on_"+edge.Data["source"].(string)+"_"+edge.Data["target"].(string), edge.Data["id"])
+		assert.NotContains(t, edge.Data, "style")
+	}
+
+	// edge rules match the dependency and the task depending on it
+	theme, err := ParseTheme([]byte("edges:\n  - from: kind:pr\n    style: {color: purple}\n"))
+	require.NoError(t, err)
+	out.Reset()
+	require.NoError(t, writeCytoscape(&out, tasks, nil, nil, theme, nil, time.Now()))
+	graph.Elements.Edges = nil
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &graph))
+	styled := map[interface{}]interface{}{}
+	for _, edge := range graph.Elements.Edges {
+		styled[edge.Data["target"]] = edge.Data["style"]
+	}
+	assert.Equal(t, map[interface{}]interface{}{
+		"https://github.com/a/b/pull/2":   map[string]interface{}{"color": "purple"},
+		"https://github.com/a/b/issues/1": nil,
+	}, styled)
+
+	// the loaded owners are the parents of the tasks without a loaded milestone
+	for i := range tasks {
+		tasks[i].HasOwner = "https://github.com/a/b"
+	}
+	owners := map[quad.IRI]dvmodel.Owner{"https://github.com/a/b": {ID: "https://github.com/a/b", LocalID: "a/b", Kind: dvmodel.Owner_Repo}}
+	out.Reset()
+	require.NoError(t, writeCytoscape(&out, tasks, nil, owners, theme, nil, time.Now()))
+	graph.Elements.Nodes = nil
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &graph))
+	require.Len(t, graph.Elements.Nodes, 4)
+	parents := map[interface{}]interface{}{}
+	for _, node := range graph.Elements.Nodes {
+		parents[node.Data["id"]] = node.Data["parent"]
+	}
+	assert.Equal(t, "Owner", graph.Elements.Nodes[0].Classes)
+	assert.Equal(t, map[interface{}]interface{}{
+		"https://github.com/a/b":             nil,
+		"https://github.com/a/b/issues/1":    "https://github.com/a/b/milestone/1",
+		"https://github.com/a/b/pull/2":      "https://github.com/a/b",
+		"https://github.com/a/b/milestone/1": "https://github.com/a/b",
+	}, parents)
+}
@@ -0,0 +1,367 @@
+package dvcore
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/graphman"
+)
+
+// diagram is a PERT graph with the theme applied, rendered by the text formats: mermaid, plantuml and d2.
+type diagram struct {
+	vertical bool
+	nodes    []diagramNode
+	edges    []diagramEdge
+}
+
+type diagramNode struct {
+	id    string // n0, n1, etc., valid in every format
+	lines []string
+	url   string
+	shape string // a Graphviz shape, or "rounded" for rounded boxes
+	style Style
+}
+
+type diagramEdge struct {
+	from, to string
+	style    Style
+}
+
+// newDiagram converts graph, the colors set on its vertices and edges (critical path, cycles) override the theme.
+func newDiagram(graph *graphman.Graph, tasks dvmodel.Tasks, theme *Theme, topics map[quad.IRI]dvmodel.Topic, now time.Time, vertical bool) diagram {
+	byID := map[string]*dvmodel.Task{}
+	for i, task := range tasks {
+		byID[string(task.ID)] = &tasks[i]
+	}
+
+	d := diagram{vertical: vertical}
+	ids := map[string]string{}
+	for i, vertex := range graph.Vertices() {
+		node := diagramNode{id: fmt.Sprintf("n%d", i), lines: []string{vertex.ID()}, shape: "box"}
+		if pert := vertex.Attrs.GetPert(); pert != nil {
+			switch {
+			case pert.IsStart || pert.IsFinish:
+				node.shape = "diamond"
+			case pert.IsAction:
+				node.shape = "rounded"
+			case pert.IsState && pert.IsUntitled:
+				node.shape, node.lines = "circle", []string{" "}
+			case pert.IsState: // milestone
+				node.style.Style = "bold"
+			case pert.IsUndefinedDependency:
+				node.shape, node.lines = "octagon", []string{"undefined dependency", vertex.ID()}
+			}
+		}
+		if task := byID[vertex.ID()]; task != nil {
+			node.lines, node.url = []string{task.LocalID, task.Title}, string(task.ID)
+			if task.LocalID == "" {
+				node.lines = node.lines[1:]
+			}
+			node.style = node.style.merge(theme.nodeStyle(*task, topics, now))
+		}
+		if node.style.Shape != "" {
+			node.shape = node.style.Shape
+		}
+		if color := vertex.Attrs.GetColor(); color != "" {
+			node.style.Color = color
+		}
+		ids[vertex.ID()] = node.id
+		d.nodes = append(d.nodes, node)
+	}
+
+	for _, edge := range graph.Edges() {
+		from, to := edge.Src().ID(), edge.Dst().ID()
+		if ids[from] == "" || ids[to] == "" {
+			continue
+		}
+		style := theme.edgeStyle(byID[from], byID[to])
+		if color := edge.Attrs.GetColor(); color != "" {
+			style.Color = color
+		}
+		if value, ok := edge.Attrs["style"].(string); ok {
+			style.Style = joinStyles(style.Style, value)
+		}
+		d.edges = append(d.edges, diagramEdge{from: ids[from], to: ids[to], style: style})
+	}
+	return d
+}
+
+// writeDiagram renders d in format: mermaid, plantuml or d2.
+func writeDiagram(w io.Writer, d diagram, format string) error {
+	switch format {
+	case "mermaid":
+		writeMermaid(w, d)
+	case "plantuml":
+		writePlantUML(w, d)
+	case "d2":
+		writeD2(w, d)
+	default:
+		return fmt.Errorf("unsupported diagram format: %q", format)
+	}
+	return nil
+}
+
+// writeMermaid writes a flowchart, which can be embedded in GitHub Markdown.
+func writeMermaid(w io.Writer, d diagram) {
+	direction := "LR"
+	if d.vertical {
+		direction = "TB"
+	}
+	fmt.Fprintf(w, "flowchart %s\n", direction)
+
+	escape := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+	for _, node := range d.nodes {
+		label := make([]string, len(node.lines))
+		for i, line := range node.lines {
+			label[i] = escape.Replace(line)
+		}
+		open, closing := mermaidShape(node.shape)
+		fmt.Fprintf(w, "    %s%s\"%s\"%s\n", node.id, open, strings.Join(label, "<br>"), closing)
+	}
+	for _, edge := range d.edges {
+		fmt.Fprintf(w, "    %s --> %s\n", edge.from, edge.to)
+	}
+	for _, node := range d.nodes {
+		if css := mermaidStyle(node.style, true); css != "" {
+			fmt.Fprintf(w, "    style %s %s\n", node.id, css)
+		}
+	}
+	for i, edge := range d.edges {
+		if css := mermaidStyle(edge.style, false); css != "" {
+			fmt.Fprintf(w, "    linkStyle %d %s\n", i, css)
+		}
+	}
+	for _, node := range d.nodes {
+		if node.url != "" {
+			fmt.Fprintf(w, "    click %s href %q _blank\n", node.id, node.url)
+		}
+	}
+}
+
+func mermaidShape(shape string) (string, string) {
+	switch shape {
+	case "rounded":
+		return "(", ")"
+	case "diamond":
+		return "{", "}"
+	case "circle", "doublecircle", "point":
+		return "((", "))"
+	case "ellipse", "oval":
+		return "([", "])"
+	case "hexagon", "octagon", "doubleoctagon":
+		return "{{", "}}"
+	default:
+		return "[", "]"
+	}
+}
+
+// mermaidStyle returns the CSS of a node or a link.
+func mermaidStyle(style Style, node bool) string {
+	css := []string{}
+	if style.Color != "" {
+		css = append(css, "stroke:"+style.Color)
+	}
+	if node && style.FillColor != "" {
+		css = append(css, "fill:"+style.FillColor)
+	}
+	if node && style.FontColor != "" {
+		css = append(css, "color:"+style.FontColor)
+	}
+	if width := styleWidth(style); width > 0 {
+		css = append(css, fmt.Sprintf("stroke-width:%dpx", width))
+	}
+	switch {
+	case hasStyle(style, "dashed"):
+		css = append(css, "stroke-dasharray:5 5")
+	case hasStyle(style, "dotted"):
+		css = append(css, "stroke-dasharray:2 2")
+	}
+	return strings.Join(css, ",")
+}
+
+// writePlantUML writes a description diagram.
+func writePlantUML(w io.Writer, d diagram) {
+	fmt.Fprintln(w, "@startuml")
+	if !d.vertical {
+		fmt.Fprintln(w, "left to right direction")
+	}
+	escape := strings.NewReplacer(`"`, "'", "\n", " ")
+	for _, node := range d.nodes {
+		label := make([]string, len(node.lines))
+		for i, line := range node.lines {
+			label[i] = escape.Replace(line)
+		}
+		fmt.Fprintf(w, "%s \"%s\" as %s", plantumlShape(node.shape), strings.Join(label, `\n`), node.id)
+		if node.url != "" {
+			fmt.Fprintf(w, " [[%s]]", node.url)
+		}
+		if style := plantumlNodeStyle(node.style); style != "" {
+			fmt.Fprintf(w, " #%s", style)
+		}
+		fmt.Fprintln(w)
+	}
+	for _, edge := range d.edges {
+		fmt.Fprintf(w, "%s -%s-> %s\n", edge.from, plantumlEdgeStyle(edge.style), edge.to)
+	}
+	fmt.Fprintln(w, "@enduml")
+}
+
+func plantumlShape(shape string) string {
+	switch shape {
+	case "rounded":
+		return "card"
+	case "diamond", "ellipse", "oval":
+		return "usecase"
+	case "circle", "doublecircle", "point":
+		return "circle"
+	case "hexagon", "octagon", "doubleoctagon":
+		return "hexagon"
+	default:
+		return "rectangle"
+	}
+}
+
+// plantumlNodeStyle returns the inline style of a node, e.g. "back:pink;line:red;line.bold".
+func plantumlNodeStyle(style Style) string {
+	parts := []string{}
+	color := func(value string) string { return strings.TrimPrefix(value, "#") }
+	if style.FillColor != "" {
+		parts = append(parts, "back:"+color(style.FillColor))
+	}
+	if style.Color != "" {
+		parts = append(parts, "line:"+color(style.Color))
+	}
+	switch {
+	case hasStyle(style, "dashed"):
+		parts = append(parts, "line.dashed")
+	case hasStyle(style, "dotted"):
+		parts = append(parts, "line.dotted")
+	}
+	if styleWidth(style) > 1 {
+		parts = append(parts, "line.bold")
+	}
+	if style.FontColor != "" {
+		parts = append(parts, "text:"+color(style.FontColor))
+	}
+	return strings.Join(parts, ";")
+}
+
+// plantumlEdgeStyle returns the bracketed style of an arrow, e.g. "[#red,bold]".
+func plantumlEdgeStyle(style Style) string {
+	parts := []string{}
+	if style.Color != "" {
+		parts = append(parts, "#"+strings.TrimPrefix(style.Color, "#"))
+	}
+	switch {
+	case hasStyle(style, "dashed"):
+		parts = append(parts, "dashed")
+	case hasStyle(style, "dotted"):
+		parts = append(parts, "dotted")
+	}
+	if styleWidth(style) > 1 {
+		parts = append(parts, "bold")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+// writeD2 writes a D2 diagram, see https://d2lang.com.
+func writeD2(w io.Writer, d diagram) {
+	direction := "right"
+	if d.vertical {
+		direction = "down"
+	}
+	fmt.Fprintf(w, "direction: %s\n", direction)
+	for _, node := range d.nodes {
+		fmt.Fprintf(w, "%s: %s {\n", node.id, strconv.Quote(strings.Join(node.lines, "\n")))
+		shape, rounded := d2Shape(node.shape)
+		fmt.Fprintf(w, "  shape: %s\n", shape)
+		if node.url != "" {
+			fmt.Fprintf(w, "  link: %s\n", strconv.Quote(node.url))
+		}
+		if rounded {
+			fmt.Fprintln(w, "  style.border-radius: 8")
+		}
+		writeD2Style(w, node.style, true)
+		fmt.Fprintln(w, "}")
+	}
+	for _, edge := range d.edges {
+		fmt.Fprintf(w, "%s -> %s", edge.from, edge.to)
+		if edge.style == (Style{}) {
+			fmt.Fprintln(w)
+			continue
+		}
+		fmt.Fprintln(w, ": {")
+		writeD2Style(w, edge.style, false)
+		fmt.Fprintln(w, "}")
+	}
+}
+
+func d2Shape(shape string) (string, bool) {
+	switch shape {
+	case "rounded":
+		return "rectangle", true
+	case "diamond":
+		return "diamond", false
+	case "circle", "doublecircle", "point":
+		return "circle", false
+	case "ellipse", "oval":
+		return "oval", false
+	case "hexagon", "octagon", "doubleoctagon":
+		return "hexagon", false
+	default:
+		return "rectangle", false
+	}
+}
+
+func writeD2Style(w io.Writer, style Style, node bool) {
+	if style.Color != "" {
+		fmt.Fprintf(w, "  style.stroke: %s\n", strconv.Quote(style.Color))
+	}
+	if node && style.FillColor != "" {
+		fmt.Fprintf(w, "  style.fill: %s\n", strconv.Quote(style.FillColor))
+	}
+	if node && style.FontColor != "" {
+		fmt.Fprintf(w, "  style.font-color: %s\n", strconv.Quote(style.FontColor))
+	}
+	if width := styleWidth(style); width > 0 {
+		fmt.Fprintf(w, "  style.stroke-width: %d\n", width)
+	}
+	switch {
+	case hasStyle(style, "dashed"):
+		fmt.Fprintln(w, "  style.stroke-dash: 5")
+	case hasStyle(style, "dotted"):
+		fmt.Fprintln(w, "  style.stroke-dash: 2")
+	}
+}
+
+// hasStyle returns true if the Graphviz style of s contains value, e.g. dashed.
+func hasStyle(s Style, value string) bool {
+	for _, item := range strings.Split(s.Style, ",") {
+		if strings.TrimSpace(item) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// styleWidth returns the line width of s, from its pen width or its bold style, or 0 for the default one.
+func styleWidth(s Style) int {
+	if width, err := strconv.ParseFloat(s.PenWidth, 64); err == nil && width > 0 {
+		if width > 15 {
+			return 15
+		}
+		return int(width + 0.5)
+	}
+	if hasStyle(s, "bold") {
+		return 3
+	}
+	return 0
+}
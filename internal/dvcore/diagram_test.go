@@ -0,0 +1,69 @@
+package dvcore
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+func TestDiagrams(t *testing.T) {
+	tasks := dvmodel.Tasks{
+		{ID: "https://example.com/1", LocalID: "example#1", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, Title: `say "hi"`},
+		{ID: "https://example.com/2", LocalID: "example#2", Kind: dvmodel.Task_MergeRequest, State: dvmodel.Task_Open, Title: "second", IsDependingOn: []quad.IRI{"https://example.com/1"}},
+		{ID: "https://example.com/3", LocalID: "example#3", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{"https://example.com/4"}},
+		{ID: "https://example.com/4", LocalID: "example#4", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{"https://example.com/3"}},
+	}
+	opts := RunOpts{Logger: zap.NewNop()}
+	graph := pertGraph(graphmanPertConfig(tasks, opts), tasks.Cycles(), opts)
+	d := newDiagram(graph, tasks, DefaultTheme(), nil, time.Now(), false)
+
+	nodes := map[string]diagramNode{}
+	for _, node := range d.nodes {
+		nodes[node.url] = node
+	}
+	require.Contains(t, nodes, "https://example.com/2")
+	assert.Equal(t, []string{"example#2", "second"}, nodes["https://example.com/2"].lines)
+	assert.Equal(t, "red", nodes["https://example.com/2"].style.Color) // critical path
+	assert.Equal(t, "dashed", nodes["https://example.com/2"].style.Style)
+	cycle := []string{}
+	for _, edge := range d.edges {
+		if edge.style.Color == cycleColor {
+			cycle = append(cycle, edge.from+" "+edge.to)
+		}
+	}
+	id := func(iri string) string { return nodes[iri].id }
+	assert.ElementsMatch(t, []string{
+		id("https://example.com/3") + " " + id("https://example.com/4"),
+		id("https://example.com/4") + " " + id("https://example.com/3"),
+	}, cycle)
+
+	var out strings.Builder
+	require.NoError(t, writeDiagram(&out, d, "mermaid"))
+	assert.True(t, strings.HasPrefix(out.String(), "flowchart LR\n"))
+	assert.Contains(t, out.String(), id("https://example.com/1")+`("example#1<br>say #quot;hi#quot;")`)
+	assert.Contains(t, out.String(), "    "+id("https://example.com/1")+" --> "+id("https://example.com/2")+"\n")
+	assert.Contains(t, out.String(), "style "+id("https://example.com/2")+" stroke:red,stroke-dasharray:5 5\n")
+	assert.Contains(t, out.String(), "stroke:orange,stroke-width:3px")
+	assert.Contains(t, out.String(), `click `+id("https://example.com/1")+` href "https://example.com/1" _blank`)
+
+	out.Reset()
+	require.NoError(t, writeDiagram(&out, d, "plantuml"))
+	assert.True(t, strings.HasPrefix(out.String(), "@startuml\nleft to right direction\n"))
+	assert.True(t, strings.HasSuffix(out.String(), "@enduml\n"))
+	assert.Contains(t, out.String(), `card "example#2\nsecond" as `+id("https://example.com/2")+` [[https://example.com/2]] #line:red;line.dashed`)
+	assert.Contains(t, out.String(), id("https://example.com/3")+" -[#orange,bold]-> "+id("https://example.com/4"))
+
+	out.Reset()
+	require.NoError(t, writeDiagram(&out, d, "d2"))
+	assert.True(t, strings.HasPrefix(out.String(), "direction: right\n"))
+	assert.Contains(t, out.String(), id("https://example.com/1")+`: "example#1\nsay \"hi\"" {`)
+	assert.Contains(t, out.String(), id("https://example.com/3")+" -> "+id("https://example.com/4")+": {\n  style.stroke: \"orange\"\n  style.stroke-width: 3\n}\n")
+
+	assert.Error(t, writeDiagram(&out, d, "svg"))
+}
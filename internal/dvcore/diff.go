@@ -0,0 +1,439 @@
+package dvcore
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvparser"
+	"moul.io/depviz/v3/internal/dvstore"
+	"moul.io/graphman"
+	"moul.io/graphman/viz"
+)
+
+type DiffOpts struct {
+	// From and To are a date or an RFC 3339 time (the current store as of that time),
+	// "now" or empty (the current store), a dump-json or dump-quads file, or a store URI.
+	From string
+	To   string
+	// Format is one of text, json or dot.
+	Format   string
+	Vertical bool
+	Logger   *zap.Logger
+	Schema   *schema.Config
+	// Store is the configuration of the current store, reused instead of being opened twice.
+	Store dvstore.StoreConfig
+}
+
+// GraphDiff lists what changed between two versions of a graph.
+type GraphDiff struct {
+	AddedTasks       []DiffTask       `json:"added_tasks,omitempty"`
+	RemovedTasks     []DiffTask       `json:"removed_tasks,omitempty"`
+	ClosedTasks      []DiffTask       `json:"closed_tasks,omitempty"`
+	ReopenedTasks    []DiffTask       `json:"reopened_tasks,omitempty"`
+	AddedEdges       []DiffEdge       `json:"added_edges,omitempty"`
+	RemovedEdges     []DiffEdge       `json:"removed_edges,omitempty"`
+	ChangedEstimates []DiffEstimation `json:"changed_estimates,omitempty"`
+}
+
+type DiffTask struct {
+	ID      quad.IRI `json:"id"`
+	LocalID string   `json:"local_id,omitempty"`
+	Title   string   `json:"title,omitempty"`
+}
+
+type DiffEdge struct {
+	From     quad.IRI `json:"from"`
+	Relation string   `json:"relation"`
+	To       quad.IRI `json:"to"`
+}
+
+type DiffEstimation struct {
+	Task DiffTask `json:"task"`
+	From string   `json:"from"`
+	To   string   `json:"to"`
+}
+
+// IsEmpty returns true if both graphs are identical.
+func (d GraphDiff) IsEmpty() bool {
+	return len(d.AddedTasks)+len(d.RemovedTasks)+len(d.ClosedTasks)+len(d.ReopenedTasks)+
+		len(d.AddedEdges)+len(d.RemovedEdges)+len(d.ChangedEstimates) == 0
+}
+
+func Diff(h *cayley.Handle, args []string, opts DiffOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	opts.Logger.Debug("Diff called", zap.Strings("args", args), zap.Any("opts", opts))
+	ctx := context.TODO()
+
+	targets, err := dvparser.ParseTargets(args)
+	if err != nil {
+		return fmt.Errorf("parse targets: %w", err)
+	}
+	// closed tasks and external deps are needed to tell closed tasks from removed ones
+	filters := dvstore.LoadTasksFilters{
+		Targets:    targets,
+		TheWorld:   len(targets) == 0,
+		WithClosed: true,
+	}
+
+	from, err := loadDiffSource(ctx, h, opts.From, filters, opts)
+	if err != nil {
+		return fmt.Errorf("load %q: %w", opts.From, err)
+	}
+	to, err := loadDiffSource(ctx, h, opts.To, filters, opts)
+	if err != nil {
+		return fmt.Errorf("load %q: %w", opts.To, err)
+	}
+
+	diff := GetGraphDiff(from, to)
+
+	switch opts.Format {
+	case "", "text":
+		printGraphDiff(os.Stdout, diff)
+		return nil
+	case "json":
+		out, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	case "dot":
+		out, err := graphDiffDOT(from, to, diff, opts)
+		if err != nil {
+			return fmt.Errorf("graphviz: %w", err)
+		}
+		fmt.Println(out)
+		return nil
+	default:
+		return fmt.Errorf("unsupported diff format: %q", opts.Format)
+	}
+}
+
+// GetGraphDiff compares two sets of tasks.
+func GetGraphDiff(from, to dvmodel.Tasks) *GraphDiff {
+	diff := GraphDiff{}
+	fromMap, toMap := tasksByID(from), tasksByID(to)
+
+	for _, task := range to {
+		previous, found := fromMap[task.ID]
+		switch {
+		case !found:
+			diff.AddedTasks = append(diff.AddedTasks, newDiffTask(task))
+		case previous.State != dvmodel.Task_Closed && task.State == dvmodel.Task_Closed:
+			diff.ClosedTasks = append(diff.ClosedTasks, newDiffTask(task))
+		case previous.State == dvmodel.Task_Closed && task.State != dvmodel.Task_Closed:
+			diff.ReopenedTasks = append(diff.ReopenedTasks, newDiffTask(task))
+		}
+		if found && previous.EstimatedDuration != task.EstimatedDuration {
+			diff.ChangedEstimates = append(diff.ChangedEstimates, DiffEstimation{
+				Task: newDiffTask(task),
+				From: previous.EstimatedDuration,
+				To:   task.EstimatedDuration,
+			})
+		}
+	}
+	for _, task := range from {
+		if _, found := toMap[task.ID]; !found {
+			diff.RemovedTasks = append(diff.RemovedTasks, newDiffTask(task))
+		}
+	}
+
+	fromEdges, toEdges := taskEdges(from), taskEdges(to)
+	for edge := range toEdges {
+		if !fromEdges[edge] {
+			diff.AddedEdges = append(diff.AddedEdges, edge)
+		}
+	}
+	for edge := range fromEdges {
+		if !toEdges[edge] {
+			diff.RemovedEdges = append(diff.RemovedEdges, edge)
+		}
+	}
+	sortDiffEdges(diff.AddedEdges)
+	sortDiffEdges(diff.RemovedEdges)
+
+	return &diff
+}
+
+// loadDiffSource loads the tasks of a diff source, see DiffOpts.From.
+func loadDiffSource(ctx context.Context, h *cayley.Handle, spec string, filters dvstore.LoadTasksFilters, opts DiffOpts) (dvmodel.Tasks, error) {
+	if spec == "" || spec == "now" {
+		return dvstore.LoadTasks(h, opts.Schema, filters, opts.Logger)
+	}
+	if asOf, err := dvparser.ParseAsOf(spec); err == nil {
+		filters.AsOf = &asOf
+		return dvstore.LoadTasks(h, opts.Schema, filters, opts.Logger)
+	}
+
+	// dump file, restored in memory
+	if info, err := os.Stat(spec); err == nil && !info.IsDir() {
+		mem, err := dvstore.OpenStore(dvstore.StoreConfig{Backend: "memstore"})
+		if err != nil {
+			return nil, err
+		}
+		defer mem.Close()
+
+		isBatch, err := isJSONBatch(spec)
+		if err != nil {
+			return nil, err
+		}
+		restoreOpts := StoreRestoreOpts{Path: spec}
+		if isBatch {
+			err = StoreRestoreJSON(mem, opts.Schema, restoreOpts)
+		} else {
+			err = StoreRestoreQuads(mem, restoreOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return dvstore.LoadTasks(mem, opts.Schema, filters, opts.Logger)
+	}
+
+	// another store
+	config, err := dvstore.ParseStoreURI(spec)
+	if err != nil {
+		return nil, err
+	}
+	if config.Backend == opts.Store.Backend && filepath.Clean(config.Address) == filepath.Clean(opts.Store.Address) {
+		return dvstore.LoadTasks(h, opts.Schema, filters, opts.Logger)
+	}
+	if config.IsLocal() {
+		if _, err := os.Stat(config.Address); err != nil {
+			return nil, fmt.Errorf("not a date, a dump or a store: %w", err)
+		}
+	}
+	store, err := dvstore.OpenStore(config)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+	if err := dvstore.CheckSchemaVersion(ctx, store); err != nil {
+		return nil, err
+	}
+	return dvstore.LoadTasks(store, opts.Schema, filters, opts.Logger)
+}
+
+// isJSONBatch returns true for dump-json files, a JSON object, and false for
+// the other formats, including JSON quads which are an array.
+func isJSONBatch(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	for {
+		c, _, err := r.ReadRune()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !strings.ContainsRune(" \t\r\n", c) {
+			return c == '{', nil
+		}
+	}
+}
+
+func printGraphDiff(w io.Writer, diff *GraphDiff) {
+	if diff.IsEmpty() {
+		fmt.Fprintln(w, "no changes")
+		return
+	}
+	fmt.Fprintf(w, "tasks: +%d -%d, %d closed, %d reopened\n", len(diff.AddedTasks), len(diff.RemovedTasks), len(diff.ClosedTasks), len(diff.ReopenedTasks))
+	fmt.Fprintf(w, "edges: +%d -%d\n", len(diff.AddedEdges), len(diff.RemovedEdges))
+	fmt.Fprintf(w, "estimates: %d changed\n", len(diff.ChangedEstimates))
+
+	for _, section := range []struct {
+		title  string
+		prefix string
+		tasks  []DiffTask
+	}{
+		{"added tasks", "+", diff.AddedTasks},
+		{"removed tasks", "-", diff.RemovedTasks},
+		{"closed tasks", "x", diff.ClosedTasks},
+		{"reopened tasks", "o", diff.ReopenedTasks},
+	} {
+		if len(section.tasks) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s:\n", section.title)
+		for _, task := range section.tasks {
+			fmt.Fprintf(w, "%s %s  %s\n", section.prefix, task.label(), task.Title)
+		}
+	}
+	for _, section := range []struct {
+		title  string
+		prefix string
+		edges  []DiffEdge
+	}{
+		{"added edges", "+", diff.AddedEdges},
+		{"removed edges", "-", diff.RemovedEdges},
+	} {
+		if len(section.edges) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s:\n", section.title)
+		for _, edge := range section.edges {
+			fmt.Fprintf(w, "%s %s %s %s\n", section.prefix, string(edge.From), edge.Relation, string(edge.To))
+		}
+	}
+	if len(diff.ChangedEstimates) > 0 {
+		fmt.Fprintln(w, "\nchanged estimates:")
+		for _, change := range diff.ChangedEstimates {
+			fmt.Fprintf(w, "~ %s  %s -> %s\n", change.Task.label(), orNone(change.From), orNone(change.To))
+		}
+	}
+}
+
+// graphDiffDOT renders the union of both graphs, with added tasks and edges
+// in green, removed ones in red and closed tasks in gray.
+func graphDiffDOT(from, to dvmodel.Tasks, diff *GraphDiff, opts DiffOpts) (string, error) {
+	colors := map[quad.IRI]string{}
+	for _, task := range diff.AddedTasks {
+		colors[task.ID] = "green"
+	}
+	for _, task := range diff.RemovedTasks {
+		colors[task.ID] = "red"
+	}
+	for _, task := range diff.ClosedTasks {
+		colors[task.ID] = "gray"
+	}
+	for _, task := range diff.ReopenedTasks {
+		colors[task.ID] = "orange"
+	}
+
+	graph := graphman.New()
+	all := tasksByID(from)
+	for _, task := range to {
+		all[task.ID] = task
+	}
+	ids := make([]quad.IRI, 0, len(all))
+	for id := range all {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		task := all[id]
+		attrs := graphman.Attrs{"shape": "box", "style": "rounded"}
+		attrs.SetTitle(fmt.Sprintf("%s\n%s", newDiffTask(task).label(), task.Title))
+		if color, found := colors[id]; found {
+			attrs.SetColor(color)
+		}
+		graph.AddVertex(string(id), attrs)
+	}
+
+	removed := map[DiffEdge]bool{}
+	for _, edge := range diff.RemovedEdges {
+		removed[edge] = true
+	}
+	added := map[DiffEdge]bool{}
+	for _, edge := range diff.AddedEdges {
+		added[edge] = true
+	}
+	edges := taskEdges(from)
+	for edge := range taskEdges(to) {
+		edges[edge] = true
+	}
+	sorted := make([]DiffEdge, 0, len(edges))
+	for edge := range edges {
+		sorted = append(sorted, edge)
+	}
+	sortDiffEdges(sorted)
+	for _, edge := range sorted {
+		if _, found := all[edge.To]; !found {
+			continue // dependency outside of the loaded tasks
+		}
+		attrs := graphman.Attrs{}
+		attrs.SetTitle(edge.Relation)
+		switch {
+		case added[edge]:
+			attrs.SetColor("green")
+		case removed[edge]:
+			attrs.SetColor("red")
+			attrs["style"] = "dashed"
+		}
+		graph.AddEdge(string(edge.From), string(edge.To), attrs)
+	}
+
+	graph.Attrs["overlap"] = "false"
+	if opts.Vertical {
+		graph.Attrs["rankdir"] = "TB"
+	}
+	return viz.ToGraphviz(graph, &viz.Opts{})
+}
+
+func newDiffTask(task dvmodel.Task) DiffTask {
+	return DiffTask{ID: task.ID, LocalID: task.LocalID, Title: task.Title}
+}
+
+func (t DiffTask) label() string {
+	if t.LocalID != "" {
+		return t.LocalID
+	}
+	return string(t.ID)
+}
+
+func tasksByID(tasks dvmodel.Tasks) map[quad.IRI]dvmodel.Task {
+	m := make(map[quad.IRI]dvmodel.Task, len(tasks))
+	for _, task := range tasks {
+		m[task.ID] = task
+	}
+	return m
+}
+
+func taskEdges(tasks dvmodel.Tasks) map[DiffEdge]bool {
+	edges := map[DiffEdge]bool{}
+	for _, task := range tasks {
+		for _, relation := range []struct {
+			name string
+			iris []quad.IRI
+		}{
+			{"isDependingOn", task.IsDependingOn},
+			{"isBlocking", task.IsBlocking},
+			{"isRelatedWith", task.IsRelatedWith},
+			{"isPartOf", task.IsPartOf},
+			{"hasPart", task.HasPart},
+		} {
+			for _, iri := range relation.iris {
+				edges[DiffEdge{From: task.ID, Relation: relation.name, To: iri}] = true
+			}
+		}
+	}
+	return edges
+}
+
+func sortDiffEdges(edges []DiffEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.Relation != b.Relation {
+			return a.Relation < b.Relation
+		}
+		return a.To < b.To
+	})
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -0,0 +1,62 @@
+package dvcore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+func TestGetGraphDiff(t *testing.T) {
+	from := dvmodel.Tasks{
+		{ID: "https://example.com/1", State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{"https://example.com/2"}},
+		{ID: "https://example.com/2", State: dvmodel.Task_Closed},
+		{ID: "https://example.com/3", State: dvmodel.Task_Open, EstimatedDuration: "1d"},
+		{ID: "https://example.com/4", State: dvmodel.Task_Open},
+	}
+	to := dvmodel.Tasks{
+		{ID: "https://example.com/1", State: dvmodel.Task_Closed, IsDependingOn: []quad.IRI{"https://example.com/3"}},
+		{ID: "https://example.com/2", State: dvmodel.Task_Open},
+		{ID: "https://example.com/3", State: dvmodel.Task_Open, EstimatedDuration: "2d"},
+		{ID: "https://example.com/5", State: dvmodel.Task_Open},
+	}
+
+	diff := GetGraphDiff(from, to)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, []DiffTask{{ID: "https://example.com/5"}}, diff.AddedTasks)
+	assert.Equal(t, []DiffTask{{ID: "https://example.com/4"}}, diff.RemovedTasks)
+	assert.Equal(t, []DiffTask{{ID: "https://example.com/1"}}, diff.ClosedTasks)
+	assert.Equal(t, []DiffTask{{ID: "https://example.com/2"}}, diff.ReopenedTasks)
+	assert.Equal(t, []DiffEdge{{From: "https://example.com/1", Relation: "isDependingOn", To: "https://example.com/3"}}, diff.AddedEdges)
+	assert.Equal(t, []DiffEdge{{From: "https://example.com/1", Relation: "isDependingOn", To: "https://example.com/2"}}, diff.RemovedEdges)
+	assert.Equal(t, []DiffEstimation{{Task: DiffTask{ID: "https://example.com/3"}, From: "1d", To: "2d"}}, diff.ChangedEstimates)
+
+	assert.True(t, GetGraphDiff(from, from).IsEmpty())
+}
+
+func TestIsJSONBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "depviz")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		content  string
+		expected bool
+	}{
+		{"  \n{\"tasks\": []}", true},
+		{"[{\"subject\": \"a\"}]", false},
+		{"<a> <b> <c> .\n", false},
+	}
+	for i, test := range tests {
+		path := filepath.Join(dir, "dump")
+		require.NoError(t, ioutil.WriteFile(path, []byte(test.content), 0600), i)
+		isBatch, err := isJSONBatch(path)
+		require.NoError(t, err, i)
+		assert.Equal(t, test.expected, isBatch, i)
+	}
+}
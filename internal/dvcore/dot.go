@@ -0,0 +1,76 @@
+package dvcore
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/awalterschulze/gographviz"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// dotGraph is a DOT graph generated by viz.ToGraphviz, parsed again to add what graphman does not support,
+// e.g. clusters or fill colors.
+type dotGraph struct {
+	*gographviz.Graph
+
+	// nodes are the node names (quoted IRIs) of the tasks
+	nodes map[quad.IRI]string
+}
+
+func parseDot(dot string) (*dotGraph, error) {
+	graph, err := gographviz.Read([]byte(dot))
+	if err != nil {
+		return nil, fmt.Errorf("parse dot: %w", err)
+	}
+	nodes := map[quad.IRI]string{}
+	for _, node := range graph.Nodes.Nodes {
+		if name, err := strconv.Unquote(node.Name); err == nil {
+			nodes[quad.IRI(name)] = node.Name
+		}
+	}
+	return &dotGraph{Graph: graph, nodes: nodes}, nil
+}
+
+// applyTheme styles the nodes of the tasks and the edges between them.
+//
+// The colors that are already set, e.g. the critical path or the cycles, are kept.
+func (g *dotGraph) applyTheme(theme *Theme, tasks dvmodel.Tasks, topics map[quad.IRI]dvmodel.Topic, now time.Time) error {
+	byNode := map[string]*dvmodel.Task{}
+	for i, task := range tasks {
+		if name, found := g.nodes[task.ID]; found {
+			byNode[name] = &tasks[i]
+		}
+	}
+
+	for name, task := range byNode {
+		if err := setDotAttrs(g.Nodes.Lookup[name].Attrs, theme.nodeStyle(*task, topics, now)); err != nil {
+			return err
+		}
+	}
+	for _, edge := range g.Edges.Edges {
+		if err := setDotAttrs(edge.Attrs, theme.edgeStyle(byNode[edge.Src], byNode[edge.Dst])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setDotAttrs(attrs gographviz.Attrs, style Style) error {
+	if current, found := attrs[gographviz.Style]; found {
+		if unquoted, err := strconv.Unquote(current); err == nil {
+			current = unquoted
+		}
+		style.Style = joinStyles(current, style.Style)
+	}
+	if _, found := attrs[gographviz.Color]; found {
+		style.Color = ""
+	}
+	for name, value := range style.attrs() {
+		if err := attrs.Add(name, strconv.Quote(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
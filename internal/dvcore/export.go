@@ -0,0 +1,431 @@
+package dvcore
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/jsonld"
+	"moul.io/depviz/v3/internal/dvstore"
+)
+
+// ExportFormats lists the formats supported by StoreExport.
+var ExportFormats = []string{"graphml", "gml", "jsonld", "nquads", "pquads"}
+
+type StoreExportOpts struct {
+	// Path is the destination file, stdout is used if empty or "-".
+	Path string
+	// Format is one of ExportFormats, guessed from Path's extension if empty.
+	Format string
+}
+
+// StoreExport writes the store in a format readable by other tools.
+//
+// graphml and gml only contain the entities (nodes with their properties as
+// attributes) and the relationships between them (edges), to be opened in
+// graph editors like Gephi or yEd. jsonld and the quad formats contain every
+// quad, jsonld expanding the depviz vocabulary to absolute IRIs.
+func StoreExport(ctx context.Context, h *cayley.Handle, opts StoreExportOpts) (err error) {
+	format, err := exportFormat(opts.Format, opts.Path)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "nquads", "pquads":
+		return StoreDumpQuads(h, StoreDumpOpts{Path: opts.Path, Format: format})
+	}
+
+	out, closeFunc, err := openOutput(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := closeFunc(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	if format == "jsonld" {
+		return writeJSONLD(ctx, out, h)
+	}
+	graph, err := newExportGraph(ctx, h)
+	if err != nil {
+		return err
+	}
+	if format == "graphml" {
+		return writeGraphML(ctx, out, graph)
+	}
+	return writeGML(ctx, out, graph)
+}
+
+func exportFormat(name string, path string) (string, error) {
+	if name == "" && path != "" && path != "-" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".graphml":
+			name = "graphml"
+		case ".gml":
+			name = "gml"
+		case ".jsonld":
+			name = "jsonld"
+		case ".nq", ".nt":
+			name = "nquads"
+		case ".pq":
+			name = "pquads"
+		}
+	}
+	if name == "" {
+		return "", fmt.Errorf("missing export format (%s)", strings.Join(ExportFormats, ", "))
+	}
+	for _, format := range ExportFormats {
+		if name == format {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported export format: %q (%s)", name, strings.Join(ExportFormats, ", "))
+}
+
+// subjectQuads returns the quads of a subject, sorted for a stable output.
+func subjectQuads(ctx context.Context, h *cayley.Handle, subject quad.Value) ([]quad.Quad, error) {
+	ref := h.ValueOf(subject)
+	if ref == nil {
+		return nil, nil
+	}
+	quads := []quad.Quad{}
+	it := h.QuadIterator(quad.Subject, ref)
+	defer it.Close()
+	for it.Next(ctx) {
+		q := h.Quad(it.Result())
+		if !q.IsValid() { // removed quads can still be iterated on some backends
+			continue
+		}
+		quads = append(quads, q)
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate quads of %s: %w", subject, err)
+	}
+	sort.Slice(quads, func(i, j int) bool {
+		return quads[i].NQuad() < quads[j].NQuad()
+	})
+	return quads, nil
+}
+
+//
+// graphml & gml
+//
+
+// exportGraph is the entity graph of a store: typed subjects are nodes,
+// their literal properties are attributes and the links between them are edges.
+//
+// Only the node IRIs and the attribute types are kept in memory, the
+// properties and the edges of a node are read again from the store when the
+// node is written.
+type exportGraph struct {
+	h     *cayley.Handle
+	nodes []quad.IRI
+	index map[quad.IRI]int
+	// attrs lists the node attributes used by at least one node, with their type (string, long, boolean).
+	attrs     map[string]string
+	attrNames []string
+}
+
+type exportNode struct {
+	id    quad.IRI
+	index int
+	attrs map[string]quad.Value
+	edges []exportEdge
+}
+
+type exportEdge struct {
+	source, target int
+	relation       string
+}
+
+func newExportGraph(ctx context.Context, h *cayley.Handle) (*exportGraph, error) {
+	graph := exportGraph{h: h, index: map[quad.IRI]int{}, attrs: map[string]string{}}
+	if ref := h.ValueOf(quad.IRI("rdf:type")); ref != nil {
+		it := h.QuadIterator(quad.Predicate, ref)
+		defer it.Close()
+		for it.Next(ctx) {
+			q := h.Quad(it.Result())
+			subject, ok := q.Subject.(quad.IRI)
+			if !ok || !q.IsValid() {
+				continue
+			}
+			if _, found := graph.index[subject]; !found {
+				graph.index[subject] = -1
+				graph.nodes = append(graph.nodes, subject)
+			}
+		}
+		if err := it.Err(); err != nil {
+			return nil, fmt.Errorf("iterate entities: %w", err)
+		}
+	}
+	sort.Slice(graph.nodes, func(i, j int) bool { return graph.nodes[i] < graph.nodes[j] })
+	for i, id := range graph.nodes {
+		graph.index[id] = i
+	}
+
+	// the attribute types are needed before writing the first node
+	for i := range graph.nodes {
+		node, err := graph.node(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range node.attrs {
+			switch value.(type) {
+			case quad.Int:
+				graph.setAttrType(name, "long")
+			case quad.Bool:
+				graph.setAttrType(name, "boolean")
+			default:
+				graph.setAttrType(name, "string")
+			}
+		}
+	}
+	for name := range graph.attrs {
+		graph.attrNames = append(graph.attrNames, name)
+	}
+	sort.Strings(graph.attrNames)
+	return &graph, nil
+}
+
+// node reads the attributes and the outgoing edges of the i-th node from the store.
+func (g *exportGraph) node(ctx context.Context, i int) (*exportNode, error) {
+	node := exportNode{id: g.nodes[i], index: i, attrs: map[string]quad.Value{}}
+	quads, err := subjectQuads(ctx, g.h, node.id)
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range quads {
+		if q.Predicate == dvstore.RevisionPredicate || q.Predicate == dvstore.SearchTermPredicate || dvstore.IsInverseQuad(q) {
+			continue
+		}
+		name := exportName(q.Predicate)
+		if q.Predicate == quad.IRI("rdf:type") {
+			node.attrs[name] = quad.String(exportName(q.Object))
+			continue
+		}
+		if object, ok := q.Object.(quad.IRI); ok {
+			if target, found := g.index[object]; found {
+				node.edges = append(node.edges, exportEdge{source: i, target: target, relation: name})
+			}
+			continue
+		}
+		if previous, found := node.attrs[name]; found { // multi-valued property
+			node.attrs[name] = quad.String(exportValue(previous) + ", " + exportValue(q.Object))
+			continue
+		}
+		node.attrs[name] = q.Object
+	}
+	return &node, nil
+}
+
+// setAttrType records the type of an attribute, mixed types fallback to string.
+func (g *exportGraph) setAttrType(name, typ string) {
+	if previous, found := g.attrs[name]; found && previous != typ {
+		typ = "string"
+	}
+	g.attrs[name] = typ
+}
+
+// label returns the name displayed by graph editors.
+func (n exportNode) label() string {
+	if localID, found := n.attrs["localId"]; found {
+		return exportValue(localID)
+	}
+	return string(n.id)
+}
+
+// eachNode calls fn for every node of the graph, in order.
+func (g *exportGraph) eachNode(ctx context.Context, fn func(node *exportNode)) error {
+	for i := range g.nodes {
+		node, err := g.node(ctx, i)
+		if err != nil {
+			return err
+		}
+		fn(node)
+	}
+	return nil
+}
+
+func writeGraphML(ctx context.Context, w io.Writer, graph *exportGraph) error {
+	escape := func(s string) string {
+		var b strings.Builder
+		_ = xml.EscapeText(&b, []byte(s))
+		return b.String()
+	}
+
+	b := bufio.NewWriter(w)
+	b.WriteString(xml.Header)
+	b.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="http://graphml.graphdrawing.org/xmlns http://graphml.graphdrawing.org/xmlns/1.0/graphml.xsd">` + "\n")
+	b.WriteString(`  <key id="label" for="node" attr.name="label" attr.type="string"/>` + "\n")
+	for i, name := range graph.attrNames {
+		fmt.Fprintf(b, "  <key id=\"n%d\" for=\"node\" attr.name=%q attr.type=%q/>\n", i, name, graph.attrs[name])
+	}
+	b.WriteString(`  <key id="relation" for="edge" attr.name="label" attr.type="string"/>` + "\n")
+	b.WriteString(`  <graph id="depviz" edgedefault="directed">` + "\n")
+	err := graph.eachNode(ctx, func(node *exportNode) {
+		fmt.Fprintf(b, "    <node id=\"%s\">\n", escape(string(node.id)))
+		fmt.Fprintf(b, "      <data key=\"label\">%s</data>\n", escape(node.label()))
+		for i, name := range graph.attrNames {
+			if value, found := node.attrs[name]; found {
+				fmt.Fprintf(b, "      <data key=\"n%d\">%s</data>\n", i, escape(exportValue(value)))
+			}
+		}
+		b.WriteString("    </node>\n")
+	})
+	if err != nil {
+		return err
+	}
+	err = graph.eachNode(ctx, func(node *exportNode) {
+		for _, edge := range node.edges {
+			fmt.Fprintf(b, "    <edge source=\"%s\" target=\"%s\">\n", escape(string(graph.nodes[edge.source])), escape(string(graph.nodes[edge.target])))
+			fmt.Fprintf(b, "      <data key=\"relation\">%s</data>\n", escape(edge.relation))
+			b.WriteString("    </edge>\n")
+		}
+	})
+	if err != nil {
+		return err
+	}
+	b.WriteString("  </graph>\n</graphml>\n")
+	return b.Flush()
+}
+
+func writeGML(ctx context.Context, w io.Writer, graph *exportGraph) error {
+	// GML strings cannot contain double quotes, they are encoded as HTML entities
+	escape := strings.NewReplacer(`&`, "&amp;", `"`, "&quot;").Replace
+
+	b := bufio.NewWriter(w)
+	b.WriteString("graph [\n  directed 1\n")
+	err := graph.eachNode(ctx, func(node *exportNode) {
+		fmt.Fprintf(b, "  node [\n    id %d\n    label \"%s\"\n    iri \"%s\"\n", node.index, escape(node.label()), escape(string(node.id)))
+		for _, name := range graph.attrNames {
+			value, found := node.attrs[name]
+			if !found {
+				continue
+			}
+			if integer, ok := value.(quad.Int); ok && graph.attrs[name] == "long" {
+				fmt.Fprintf(b, "    %s %d\n", name, integer)
+			} else {
+				fmt.Fprintf(b, "    %s \"%s\"\n", name, escape(exportValue(value)))
+			}
+		}
+		b.WriteString("  ]\n")
+	})
+	if err != nil {
+		return err
+	}
+	err = graph.eachNode(ctx, func(node *exportNode) {
+		for _, edge := range node.edges {
+			fmt.Fprintf(b, "  edge [\n    source %d\n    target %d\n    label \"%s\"\n  ]\n", edge.source, edge.target, escape(edge.relation))
+		}
+	})
+	if err != nil {
+		return err
+	}
+	b.WriteString("]\n")
+	return b.Flush()
+}
+
+// exportName returns the local name of an IRI, i.e. "schema:localId" becomes "localId".
+func exportName(v quad.Value) string {
+	iri, ok := v.(quad.IRI)
+	if !ok {
+		return v.String()
+	}
+	name := string(iri)
+	if i := strings.LastIndexAny(name, ":/#"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+func exportValue(v quad.Value) string {
+	switch native := quad.NativeOf(v).(type) {
+	case time.Time:
+		return native.UTC().Format(time.RFC3339)
+	case nil:
+		return v.String()
+	default:
+		return fmt.Sprint(native)
+	}
+}
+
+//
+// jsonld
+//
+
+// depviz vocabulary namespaces, used to turn the prefixed and relative IRIs
+// of the store into the absolute IRIs required by JSON-LD.
+const (
+	exportDepvizNamespace = "https://moul.io/depviz/ns#"
+	exportSchemaNamespace = "http://schema.org/"
+	exportRDFNamespace    = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	exportXSDNamespace    = "http://www.w3.org/2001/XMLSchema#"
+)
+
+func writeJSONLD(ctx context.Context, w io.Writer, h *cayley.Handle) error {
+	var expand func(v quad.Value) quad.Value
+	expand = func(v quad.Value) quad.Value {
+		if typed, ok := v.(quad.TypedStringer); ok { // typed literals, i.e. xsd:integer
+			literal := typed.TypedString()
+			literal.Type = expand(literal.Type).(quad.IRI)
+			return literal
+		}
+		iri, ok := v.(quad.IRI)
+		if !ok {
+			return v
+		}
+		s := string(iri)
+		switch {
+		case strings.HasPrefix(s, "xsd:"):
+			return quad.IRI(exportXSDNamespace + strings.TrimPrefix(s, "xsd:"))
+		case strings.HasPrefix(s, "dv:"):
+			return quad.IRI(exportDepvizNamespace + strings.TrimPrefix(s, "dv:"))
+		case strings.HasPrefix(s, "schema:"):
+			return quad.IRI(exportSchemaNamespace + strings.TrimPrefix(s, "schema:"))
+		case strings.HasPrefix(s, "rdf:"):
+			return quad.IRI(exportRDFNamespace + strings.TrimPrefix(s, "rdf:"))
+		case !strings.Contains(s, ":"): // relative predicates, i.e. isDependingOn
+			return quad.IRI(exportDepvizNamespace + s)
+		}
+		return iri
+	}
+
+	qw := jsonld.NewWriter(w)
+	qw.SetLdContext(map[string]interface{}{
+		"@vocab": exportDepvizNamespace,
+		"dv":     exportDepvizNamespace,
+		"schema": exportSchemaNamespace,
+		"rdf":    exportRDFNamespace,
+		"xsd":    exportXSDNamespace,
+	})
+	it := h.QuadsAllIterator()
+	defer it.Close()
+	for it.Next(ctx) {
+		q := h.Quad(it.Result())
+		if !q.IsValid() { // removed quads can still be iterated on some backends
+			continue
+		}
+		expanded := quad.Make(expand(q.Subject), expand(q.Predicate), expand(q.Object), expand(q.Label))
+		if err := qw.WriteQuad(expanded); err != nil {
+			return fmt.Errorf("write quad: %w", err)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("iterate quads: %w", err)
+	}
+	if err := qw.Close(); err != nil {
+		return fmt.Errorf("close jsonld writer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package dvcore
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvstore"
+)
+
+func TestStoreExport(t *testing.T) {
+	store, closeStore := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeStore()
+
+	dir, err := ioutil.TempDir("", "depviz")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	export := func(format string, name string) []byte {
+		path := filepath.Join(dir, name)
+		err := StoreExport(context.Background(), store, StoreExportOpts{Path: path, Format: format})
+		require.NoError(t, err, name)
+		out, err := ioutil.ReadFile(path)
+		require.NoError(t, err, name)
+		return out
+	}
+
+	// graphml, entities only
+	var graphml struct {
+		Nodes []struct {
+			ID string `xml:"id,attr"`
+		} `xml:"graph>node"`
+		Edges []struct {
+			Source string `xml:"source,attr"`
+			Target string `xml:"target,attr"`
+			Label  string `xml:"data"`
+		} `xml:"graph>edge"`
+	}
+	require.NoError(t, xml.Unmarshal(export("", "dump.graphml"), &graphml))
+	assert.Len(t, graphml.Nodes, 19+4+3)
+	nodes := map[string]bool{}
+	for _, node := range graphml.Nodes {
+		nodes[node.ID] = true
+	}
+	hasDependency := false
+	for _, edge := range graphml.Edges {
+		assert.True(t, nodes[edge.Source], edge.Source)
+		assert.True(t, nodes[edge.Target], edge.Target)
+		hasDependency = hasDependency || edge.Label == "isDependingOn"
+	}
+	assert.True(t, hasDependency)
+
+	// gml
+	gml := string(export("gml", "dump"))
+	assert.True(t, strings.HasPrefix(gml, "graph [\n  directed 1\n"))
+	assert.Equal(t, len(graphml.Nodes), strings.Count(gml, "\n  node [\n"))
+	assert.Equal(t, len(graphml.Edges), strings.Count(gml, "\n  edge [\n"))
+
+	// jsonld keeps the relative predicates
+	var jsonld struct {
+		Graph []map[string]interface{} `json:"@graph"`
+	}
+	require.NoError(t, json.Unmarshal(export("", "dump.jsonld"), &jsonld))
+	hasDependency = false
+	for _, entity := range jsonld.Graph {
+		_, found := entity["isDependingOn"]
+		hasDependency = hasDependency || found
+	}
+	assert.True(t, hasDependency)
+
+	// quads are lossless
+	dst, closeDst := dvstore.TestingStore(t)
+	defer closeDst()
+	export("", "dump.pq")
+	require.NoError(t, StoreRestoreQuads(dst, StoreRestoreOpts{Path: filepath.Join(dir, "dump.pq")}))
+	assert.Equal(t, countQuads(t, store), countQuads(t, dst))
+
+	// errors
+	assert.Error(t, StoreExport(context.Background(), store, StoreExportOpts{Path: filepath.Join(dir, "dump")}))
+	assert.Error(t, StoreExport(context.Background(), store, StoreExportOpts{Format: "dot"}))
+}
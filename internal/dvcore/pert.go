@@ -0,0 +1,154 @@
+package dvcore
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/graphman"
+)
+
+const (
+	// DefaultEstimate is the duration given to the tasks without estimate when RunOpts.DefaultEstimate is zero.
+	DefaultEstimate = graphman.Day
+	// NoDefaultEstimate, or any negative RunOpts.DefaultEstimate, leaves the tasks without estimate out of the PERT durations.
+	NoDefaultEstimate time.Duration = -1
+)
+
+// taskEstimate returns the estimate of a task in days, and false if the task has no valid estimate, see dvmodel.ParseEstimate.
+func taskEstimate(task dvmodel.Task) ([]float64, bool) {
+	if task.EstimatedDuration == "" {
+		return nil, false
+	}
+	durations, err := dvmodel.ParseEstimate(task.EstimatedDuration) // also rejects the "undefined" and "invalid" markers of the providers
+	if err != nil {
+		return nil, false
+	}
+	estimate := make([]float64, len(durations))
+	for i, duration := range durations {
+		estimate[i] = float64(duration) / float64(graphman.Day)
+	}
+	return estimate, true
+}
+
+func graphmanPertConfig(tasks []dvmodel.Task, opts RunOpts) *graphman.PertConfig {
+	opts.Logger.Debug("graphTargets", zap.Int("tasks", len(tasks)), zap.Any("opts", opts))
+
+	// initialize graph config
+	config := graphman.PertConfig{
+		Actions: []graphman.PertAction{},
+		States:  []graphman.PertState{},
+	}
+	config.Opts.NoSimplify = false
+
+	// a milestone is reached when its tasks are done, so it is estimated from their own estimates
+	contents := map[string][]string{}
+	for _, task := range tasks {
+		if task.HasMilestone != "" && task.Kind != dvmodel.Task_Milestone {
+			contents[string(task.HasMilestone)] = append(contents[string(task.HasMilestone)], string(task.ID))
+		}
+	}
+	if opts.DefaultEstimate == 0 {
+		opts.DefaultEstimate = DefaultEstimate
+	}
+	defaultEstimate := float64(opts.DefaultEstimate) / float64(graphman.Day)
+
+	// process tasks
+	for _, task := range tasks {
+		// compute dependsOn
+		dependsOn := []string{}
+		for _, dep := range task.IsDependingOn {
+			dependsOn = append(dependsOn, string(dep))
+		}
+
+		switch task.Kind { // nolint:exhaustive
+		case dvmodel.Task_Issue, dvmodel.Task_MergeRequest:
+			action := graphman.PertAction{
+				ID:        string(task.ID),
+				Title:     task.Title,
+				DependsOn: dependsOn,
+			}
+			if estimate, found := taskEstimate(task); found {
+				action.Estimate = estimate
+			} else if defaultEstimate > 0 {
+				action.Estimate = []float64{defaultEstimate}
+			}
+			config.Actions = append(config.Actions, action)
+		case dvmodel.Task_Milestone:
+			config.States = append(
+				config.States,
+				graphman.PertState{
+					ID:        string(task.ID),
+					Title:     task.Title,
+					DependsOn: append(dependsOn, contents[string(task.ID)]...),
+				},
+			)
+		default:
+			opts.Logger.Warn("unsupported task kind", zap.Stringer("kind", task.Kind))
+		}
+	}
+
+	return &config
+}
+
+// criticalPath returns the longest path between the Start and Finish vertices of a PERT graph, weighted by the
+// estimates of its actions, and its duration in days.
+//
+// Dependency cycles are broken where they are found, so the result is only meaningful for the acyclic parts.
+func criticalPath(graph *graphman.Graph) (graphman.Path, float64) {
+	start, finish := graph.GetVertex("Start"), graph.GetVertex("Finish")
+	if start == nil || finish == nil {
+		return nil, 0
+	}
+
+	type result struct {
+		next     *graphman.Edge // nil for Finish and for the vertices not reaching it
+		duration float64
+		reaches  bool
+	}
+	results := map[string]result{}
+	visiting := map[string]bool{}
+	var walk func(vertex *graphman.Vertex) result
+	walk = func(vertex *graphman.Vertex) result {
+		if found, ok := results[vertex.ID()]; ok {
+			return found
+		}
+		if vertex == finish {
+			return result{reaches: true}
+		}
+		visiting[vertex.ID()] = true
+		best := result{}
+		for _, edge := range vertex.SuccessorEdges() {
+			if visiting[edge.Dst().ID()] { // back edge of a cycle
+				continue
+			}
+			following := walk(edge.Dst())
+			if following.reaches && (!best.reaches || following.duration > best.duration) {
+				best = result{next: edge, duration: following.duration, reaches: true}
+			}
+		}
+		visiting[vertex.ID()] = false
+		best.duration += vertexEstimate(vertex)
+		results[vertex.ID()] = best
+		return best
+	}
+	total := walk(start)
+	if !total.reaches {
+		return nil, 0
+	}
+
+	path := graphman.Path{}
+	for edge := results[start.ID()].next; edge != nil; edge = results[edge.Dst().ID()].next {
+		path = append(path, edge)
+	}
+	return path, total.duration
+}
+
+// vertexEstimate returns the weighted estimate of an action of a non-standard PERT graph, in days.
+func vertexEstimate(vertex *graphman.Vertex) float64 {
+	pert := vertex.GetPert()
+	if pert == nil || !pert.IsAction {
+		return 0
+	}
+	return pert.WeightedEstimate()
+}
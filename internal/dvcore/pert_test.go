@@ -0,0 +1,119 @@
+package dvcore
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/graphman"
+)
+
+func TestPertEstimates(t *testing.T) {
+	milestone := quad.IRI("https://example.com/milestone/1")
+	tasks := dvmodel.Tasks{
+		{ID: "https://example.com/1", Kind: dvmodel.Task_Issue, EstimatedDuration: "3d", HasMilestone: milestone},
+		{ID: "https://example.com/2", Kind: dvmodel.Task_Issue, EstimatedDuration: "undefined", HasMilestone: milestone},
+		{ID: "https://example.com/3", Kind: dvmodel.Task_Issue, EstimatedDuration: "1w", HasMilestone: milestone, IsDependingOn: []quad.IRI{"https://example.com/1", "https://example.com/2"}},
+		{ID: "https://example.com/4", Kind: dvmodel.Task_MergeRequest, EstimatedDuration: "12h"},
+		{ID: milestone, Kind: dvmodel.Task_Milestone},
+		{ID: "https://example.com/5", Kind: dvmodel.Task_Issue, IsDependingOn: []quad.IRI{milestone}},
+	}
+	opts := RunOpts{Logger: zap.NewNop(), DefaultEstimate: 2 * graphman.Day}
+	config := graphmanPertConfig(tasks, opts)
+
+	estimates := map[string][]float64{}
+	for _, action := range config.Actions {
+		estimates[action.ID] = action.Estimate
+	}
+	assert.Equal(t, map[string][]float64{
+		"https://example.com/1": {3},
+		"https://example.com/2": {2},
+		"https://example.com/3": {7},
+		"https://example.com/4": {0.5},
+		"https://example.com/5": {2},
+	}, estimates)
+	require.Len(t, config.States, 1)
+	assert.Equal(t, []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"}, config.States[0].DependsOn)
+
+	path, duration := criticalPath(graphman.FromPertConfig(*config))
+	assert.Equal(t, 12.0, duration)
+	ids := []string{}
+	for _, vertex := range path.Vertices() {
+		ids = append(ids, vertex.ID())
+	}
+	assert.Equal(t, []string{"Start", "https://example.com/1", "https://example.com/3", "https://example.com/5", "Finish"}, ids)
+
+	// without default estimate, the unestimated tasks are ignored
+	opts.DefaultEstimate = NoDefaultEstimate
+	config = graphmanPertConfig(tasks, opts)
+	assert.Empty(t, config.Actions[4].Estimate)
+	_, duration = criticalPath(graphman.FromPertConfig(*config))
+	assert.Equal(t, 10.0, duration)
+
+	// the zero value falls back to DefaultEstimate
+	opts.DefaultEstimate = 0
+	config = graphmanPertConfig(tasks, opts)
+	assert.Equal(t, []float64{1}, config.Actions[4].Estimate)
+}
+
+func TestCriticalPathCycle(t *testing.T) {
+	tasks := dvmodel.Tasks{
+		{ID: "https://example.com/1", Kind: dvmodel.Task_Issue, EstimatedDuration: "1d", IsDependingOn: []quad.IRI{"https://example.com/2"}},
+		{ID: "https://example.com/2", Kind: dvmodel.Task_Issue, EstimatedDuration: "2d", IsDependingOn: []quad.IRI{"https://example.com/1"}},
+		{ID: "https://example.com/3", Kind: dvmodel.Task_Issue, EstimatedDuration: "1d"},
+	}
+	config := graphmanPertConfig(tasks, RunOpts{Logger: zap.NewNop()})
+	path, duration := criticalPath(graphman.FromPertConfig(*config))
+	assert.NotNil(t, path)
+	assert.Equal(t, 1.0, duration)
+}
+
+func TestPertReport(t *testing.T) {
+	tasks := dvmodel.Tasks{
+		{ID: "https://example.com/1", LocalID: "example#1", Kind: dvmodel.Task_Issue, EstimatedDuration: "1d/2d/9d"},
+		{ID: "https://example.com/2", LocalID: "example#2", Kind: dvmodel.Task_Issue, EstimatedDuration: "1d"},
+		{ID: "https://example.com/3", LocalID: "example#3", Kind: dvmodel.Task_Issue, EstimatedDuration: "2d", IsDependingOn: []quad.IRI{"https://example.com/1", "https://example.com/2", "https://example.com/unknown"}},
+		{ID: "https://example.com/4", LocalID: "example#4", Kind: dvmodel.Task_Issue},
+	}
+	config := graphmanPertConfig(tasks, RunOpts{Logger: zap.NewNop(), DefaultEstimate: graphman.Day})
+	report := pertReport(config, tasks)
+
+	assert.Equal(t, 5.0, report.ExpectedDuration)
+	assert.InDelta(t, 8.0/6, report.StandardDeviation, 1e-9)
+	assert.Equal(t, []string{"https://example.com/1", "https://example.com/3"}, report.CriticalPath)
+	schedule := map[string][4]float64{}
+	critical, unestimated := []string{}, []string{}
+	for _, task := range report.Tasks {
+		schedule[task.LocalID] = [4]float64{task.Estimate, task.EarliestStart, task.LatestStart, task.Slack}
+		if task.Critical {
+			critical = append(critical, task.LocalID)
+		}
+		if !task.Estimated {
+			unestimated = append(unestimated, task.LocalID)
+		}
+	}
+	assert.Equal(t, map[string][4]float64{
+		"example#1": {3, 0, 0, 0},
+		"example#2": {1, 0, 2, 2},
+		"example#3": {2, 3, 3, 0},
+		"example#4": {1, 0, 4, 4},
+	}, schedule)
+	assert.Equal(t, []string{"example#1", "example#3"}, critical)
+	assert.Equal(t, []string{"example#4"}, unestimated)
+
+	for format, expected := range map[string]string{
+		"text":     "expected duration: 5d (σ 1.33d)",
+		"markdown": "| [example#1](https://example.com/1) |  | 3d ±1.33d | 0d | 0d | 0d | ✔ |",
+		"json":     `"critical_path": [`,
+	} {
+		var out strings.Builder
+		require.NoError(t, writePertReport(&out, report, format), format)
+		assert.Contains(t, out.String(), expected, format)
+	}
+	assert.Error(t, writePertReport(ioutil.Discard, report, "yaml"))
+}
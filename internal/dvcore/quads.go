@@ -0,0 +1,56 @@
+package dvcore
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// writeSubgraphQuads writes the loaded tasks, with the owners and topics they reference, in a cayley quad format.
+func writeSubgraphQuads(w io.Writer, schema *schema.Config, formatName string, tasks dvmodel.Tasks, owners map[quad.IRI]dvmodel.Owner, topics map[quad.IRI]dvmodel.Topic) error {
+	format, err := quadFormat(formatName, "")
+	if err != nil {
+		return err
+	}
+	if format.Writer == nil {
+		return fmt.Errorf("format %q does not support writing", format.Name)
+	}
+
+	ownerIDs := make([]quad.IRI, 0, len(owners))
+	for id := range owners {
+		ownerIDs = append(ownerIDs, id)
+	}
+	sort.Slice(ownerIDs, func(i, j int) bool { return ownerIDs[i] < ownerIDs[j] })
+	topicIDs := make([]quad.IRI, 0, len(topics))
+	for id := range topics {
+		topicIDs = append(topicIDs, id)
+	}
+	sort.Slice(topicIDs, func(i, j int) bool { return topicIDs[i] < topicIDs[j] })
+
+	entities := []interface{}{}
+	for _, id := range ownerIDs {
+		entities = append(entities, owners[id])
+	}
+	for _, task := range tasks {
+		entities = append(entities, task)
+	}
+	for _, id := range topicIDs {
+		entities = append(entities, topics[id])
+	}
+
+	qw := format.Writer(w)
+	for _, entity := range entities {
+		if _, err := schema.WriteAsQuads(qw, entity); err != nil {
+			_ = qw.Close()
+			return fmt.Errorf("write as quads: %w", err)
+		}
+	}
+	if err := qw.Close(); err != nil {
+		return fmt.Errorf("close %s writer: %w", format.Name, err)
+	}
+	return nil
+}
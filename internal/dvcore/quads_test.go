@@ -0,0 +1,51 @@
+package dvcore
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/nquads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvparser"
+	"moul.io/depviz/v3/internal/dvstore"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestWriteSubgraphQuads(t *testing.T) {
+	ctx := context.Background()
+	store, close := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	targets, err := dvparser.ParseTargets([]string{"moul/depviz-test"})
+	require.NoError(t, err)
+	tasks, err := dvstore.LoadTasks(store, schemaConfig, dvstore.LoadTasksFilters{Targets: targets, WithClosed: true}, testutil.Logger(t))
+	require.NoError(t, err)
+	topics, err := dvstore.LoadTopics(ctx, store, schemaConfig, tasks)
+	require.NoError(t, err)
+	assert.Contains(t, topics, quad.IRI("https://github.com/moul/depviz-test/labels/bug"))
+	owners, err := dvstore.LoadOwners(ctx, store, schemaConfig, tasks, topics)
+	require.NoError(t, err)
+	assert.Contains(t, owners, quad.IRI("https://github.com/moul/depviz-test"))
+	assert.Contains(t, owners, quad.IRI("https://github.com/moul")) // owner of the repo
+
+	var b bytes.Buffer
+	require.NoError(t, writeSubgraphQuads(&b, schemaConfig, "", tasks, owners, topics))
+	subjects := map[quad.IRI]bool{}
+	reader := nquads.NewReader(&b, false)
+	for {
+		q, err := reader.ReadQuad()
+		if err != nil {
+			break
+		}
+		subjects[q.Subject.(quad.IRI)] = true
+	}
+	assert.Len(t, subjects, len(tasks)+len(owners)+len(topics))
+	for _, task := range tasks {
+		assert.True(t, subjects[task.ID], string(task.ID))
+	}
+
+	assert.Error(t, writeSubgraphQuads(&b, schemaConfig, "svg", tasks, owners, topics))
+}
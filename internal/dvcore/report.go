@@ -0,0 +1,305 @@
+package dvcore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/graphman"
+)
+
+// PertReport is the critical path method applied to the PERT config of a graph, see graphmanPertConfig.
+//
+// Every duration is in days, relative to the start of the project.
+type PertReport struct {
+	ExpectedDuration  float64    `json:"expected_duration"`
+	StandardDeviation float64    `json:"standard_deviation"`
+	CriticalPath      []string   `json:"critical_path"`
+	Tasks             []PertTask `json:"tasks"`
+	// Cycles are the dependency cycles, broken arbitrarily to compute the schedule.
+	Cycles []*dvmodel.Cycle `json:"cycles,omitempty"`
+}
+
+// PertTask is the schedule of a task in a PertReport.
+type PertTask struct {
+	ID                string  `json:"id"`
+	LocalID           string  `json:"local_id,omitempty"`
+	Title             string  `json:"title,omitempty"`
+	Kind              string  `json:"kind"`
+	Estimate          float64 `json:"estimate"`
+	StandardDeviation float64 `json:"standard_deviation"`
+	// Estimated is false for the tasks using the default estimate and for the milestones.
+	Estimated      bool    `json:"estimated"`
+	EarliestStart  float64 `json:"earliest_start"`
+	EarliestFinish float64 `json:"earliest_finish"`
+	LatestStart    float64 `json:"latest_start"`
+	LatestFinish   float64 `json:"latest_finish"`
+	Slack          float64 `json:"slack"`
+	Critical       bool    `json:"critical"`
+}
+
+func (t PertTask) label() string {
+	if t.LocalID != "" {
+		return t.LocalID
+	}
+	return t.ID
+}
+
+// pertReport schedules the actions and states of config, with the three-point estimates of the actions.
+//
+// The critical path is the one of criticalPath, the other tasks are scheduled around it.
+// Dependencies outside of config are ignored, and dependency cycles are broken where they are found.
+func pertReport(config *graphman.PertConfig, tasks []dvmodel.Task) PertReport {
+	type node struct {
+		task          PertTask
+		deps          []string
+		successorsMin float64
+	}
+	byID := map[string]dvmodel.Task{}
+	for _, task := range tasks {
+		byID[string(task.ID)] = task
+	}
+	nodes := map[string]*node{}
+	newNode := func(id, title string, deps []string) *node {
+		task := byID[id]
+		n := &node{deps: deps}
+		n.task = PertTask{ID: id, LocalID: task.LocalID, Title: title, Kind: strings.ToLower(task.Kind.String())}
+		nodes[id] = n
+		return n
+	}
+	graph := graphman.FromPertConfig(*config)
+	for _, action := range config.Actions {
+		n := newNode(action.ID, action.Title, action.DependsOn)
+		if pert := graph.GetVertex(action.ID).GetPert(); pert != nil {
+			n.task.Estimate = pert.WeightedEstimate()
+			n.task.StandardDeviation = pert.StandardDeviation()
+		}
+		_, n.task.Estimated = taskEstimate(byID[action.ID])
+	}
+	for _, state := range config.States {
+		newNode(state.ID, state.Title, state.DependsOn)
+	}
+
+	// the critical path ends with the last task to finish, and its variance is the sum of the variances of its tasks
+	report := PertReport{CriticalPath: []string{}, Tasks: []PertTask{}, Cycles: dvmodel.Tasks(tasks).Cycles()}
+	path, duration := criticalPath(graph)
+	if path == nil {
+		return report
+	}
+	report.ExpectedDuration = duration
+	variance := 0.0
+	for _, vertex := range path.Vertices() {
+		if n := nodes[vertex.ID()]; n != nil {
+			report.CriticalPath = append(report.CriticalPath, vertex.ID())
+			variance += n.task.StandardDeviation * n.task.StandardDeviation
+		}
+	}
+	report.StandardDeviation = math.Sqrt(variance)
+
+	// topological order, skipping the unknown dependencies and the back edges of cycles
+	ids := make([]string, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	order := []string{}
+	visited, visiting := map[string]bool{}, map[string]bool{}
+	var visit func(id string)
+	visit = func(id string) {
+		if visited[id] {
+			return
+		}
+		visiting[id] = true
+		n := nodes[id]
+		kept := []string{}
+		seen := map[string]bool{}
+		for _, dep := range n.deps {
+			if nodes[dep] == nil || visiting[dep] || seen[dep] {
+				continue
+			}
+			visit(dep)
+			seen[dep] = true
+			kept = append(kept, dep)
+		}
+		n.deps = kept
+		visiting[id] = false
+		visited[id] = true
+		order = append(order, id)
+	}
+	for _, id := range ids {
+		visit(id)
+	}
+
+	// forward pass
+	for _, id := range order {
+		n := nodes[id]
+		for _, dep := range n.deps {
+			n.task.EarliestStart = math.Max(n.task.EarliestStart, nodes[dep].task.EarliestFinish)
+		}
+		n.task.EarliestFinish = n.task.EarliestStart + n.task.Estimate
+	}
+
+	// backward pass
+	for _, id := range order {
+		nodes[id].successorsMin = report.ExpectedDuration
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		n := nodes[order[i]]
+		n.task.LatestFinish = n.successorsMin
+		n.task.LatestStart = n.task.LatestFinish - n.task.Estimate
+		n.task.Slack = roundDays(n.task.LatestStart - n.task.EarliestStart)
+		n.task.Critical = n.task.Slack == 0
+		for _, dep := range n.deps {
+			nodes[dep].successorsMin = math.Min(nodes[dep].successorsMin, n.task.LatestStart)
+		}
+	}
+
+	for _, id := range order {
+		report.Tasks = append(report.Tasks, nodes[id].task)
+	}
+	sort.SliceStable(report.Tasks, func(i, j int) bool {
+		a, b := report.Tasks[i], report.Tasks[j]
+		if a.EarliestStart != b.EarliestStart {
+			return a.EarliestStart < b.EarliestStart
+		}
+		return a.ID < b.ID
+	})
+	return report
+}
+
+// writePertReport writes report as text, markdown or json.
+func writePertReport(w io.Writer, report PertReport, format string) error {
+	switch format {
+	case "text", "":
+		return printPertReport(w, report)
+	case "markdown":
+		printPertReportMarkdown(w, report)
+		return nil
+	case "json":
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(out))
+		return nil
+	default:
+		return fmt.Errorf("unsupported report format: %q", format)
+	}
+}
+
+func printPertReport(w io.Writer, report PertReport) error {
+	if len(report.Tasks) == 0 {
+		fmt.Fprintln(w, "no tasks")
+		return nil
+	}
+	fmt.Fprintf(w, "expected duration: %s (σ %s)\n", formatDays(report.ExpectedDuration), formatDays(report.StandardDeviation))
+	if len(report.Cycles) > 0 {
+		fmt.Fprintf(w, "warning: %d dependency cycles, the schedule is not reliable, see --format cycles\n", len(report.Cycles))
+	}
+
+	byID := map[string]PertTask{}
+	for _, task := range report.Tasks {
+		byID[task.ID] = task
+	}
+	fmt.Fprintf(w, "\ncritical path (%d tasks):\n", len(report.CriticalPath))
+	for _, id := range report.CriticalPath {
+		task := byID[id]
+		fmt.Fprintf(w, "  %s  %s  (%s)\n", task.label(), task.Title, formatDays(task.Estimate))
+	}
+
+	fmt.Fprintln(w, "\ntasks:")
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "  TASK\tESTIMATE\tEARLIEST START\tLATEST START\tSLACK\tTITLE")
+	for _, task := range report.Tasks {
+		marker := " "
+		if task.Critical {
+			marker = "*"
+		}
+		fmt.Fprintf(tw, "%s %s\t%s\t%s\t%s\t%s\t%s\n", marker, task.label(), formatEstimate(task), formatDays(task.EarliestStart), formatDays(task.LatestStart), formatDays(task.Slack), task.Title)
+	}
+	return tw.Flush()
+}
+
+func printPertReportMarkdown(w io.Writer, report PertReport) {
+	fmt.Fprintln(w, "# PERT report")
+	fmt.Fprintln(w)
+	if len(report.Tasks) == 0 {
+		fmt.Fprintln(w, "No tasks.")
+		return
+	}
+	fmt.Fprintf(w, "Expected duration: **%s** (σ %s)\n", formatDays(report.ExpectedDuration), formatDays(report.StandardDeviation))
+	if len(report.Cycles) > 0 {
+		fmt.Fprintf(w, "\n> **Warning:** %d dependency cycles, the schedule is not reliable.\n", len(report.Cycles))
+	}
+
+	byID := map[string]PertTask{}
+	for _, task := range report.Tasks {
+		byID[task.ID] = task
+	}
+	fmt.Fprintln(w, "\n## Critical path")
+	fmt.Fprintln(w)
+	for i, id := range report.CriticalPath {
+		task := byID[id]
+		fmt.Fprintf(w, "%d. [%s](%s) %s (%s)\n", i+1, task.label(), task.ID, task.Title, formatDays(task.Estimate))
+	}
+
+	if len(report.Cycles) > 0 {
+		fmt.Fprintln(w, "\n## Dependency cycles")
+		fmt.Fprintln(w)
+		for i, cycle := range report.Cycles {
+			links := []string{}
+			for _, id := range append(cycle.Path, cycle.Path[0]) {
+				task := PertTask{ID: string(id), LocalID: byID[string(id)].LocalID}
+				links = append(links, fmt.Sprintf("[%s](%s)", task.label(), task.ID))
+			}
+			fmt.Fprintf(w, "%d. %s\n", i+1, strings.Join(links, " → "))
+		}
+	}
+
+	fmt.Fprintln(w, "\n## Tasks")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Task | Title | Estimate | Earliest start | Latest start | Slack | Critical |")
+	fmt.Fprintln(w, "|------|-------|---------:|---------------:|-------------:|------:|:--------:|")
+	for _, task := range report.Tasks {
+		critical := ""
+		if task.Critical {
+			critical = "✔"
+		}
+		title := strings.ReplaceAll(task.Title, "|", `\|`)
+		fmt.Fprintf(w, "| [%s](%s) | %s | %s | %s | %s | %s | %s |\n", task.label(), task.ID, title, formatEstimate(task), formatDays(task.EarliestStart), formatDays(task.LatestStart), formatDays(task.Slack), critical)
+	}
+}
+
+// formatEstimate formats the estimate of task, marking the default ones with a tilde.
+func formatEstimate(task PertTask) string {
+	if task.Kind == "milestone" {
+		return "-"
+	}
+	estimate := formatDays(task.Estimate)
+	if !task.Estimated {
+		estimate = "~" + estimate
+	}
+	if task.StandardDeviation > 0 {
+		estimate += " ±" + formatDays(task.StandardDeviation)
+	}
+	return estimate
+}
+
+func formatDays(days float64) string {
+	out := strconv.FormatFloat(roundDays(days), 'f', 2, 64)
+	out = strings.TrimRight(out, "0")
+	out = strings.TrimRight(out, ".")
+	return out + "d"
+}
+
+// roundDays rounds days to the minute, to avoid floating point noise, e.g. in slacks.
+func roundDays(days float64) float64 {
+	const minutes = 24 * 60
+	return math.Round(days*minutes) / minutes
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"sync"
+	"time"
 
 	"github.com/cayleygraph/cayley"
-	"github.com/cayleygraph/cayley/graph"
 	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
 	"go.uber.org/zap"
 	yaml "gopkg.in/yaml.v2"
 	"moul.io/depviz/v3/internal/dvmodel"
@@ -34,17 +37,27 @@ type RunOpts struct {
 	// GitLabToken string
 	// TrelloToken string
 	// JiraToken string
-	Resync bool
+	Resync    bool
+	Snapshots dvstore.SnapshotPolicy
 
 	// graph
 
 	Format           string
 	Vertical         bool
 	NoPert           bool
+	DefaultEstimate  time.Duration
+	ReportFormat     string
+	ClusterBy        string
+	QuadFormat       string
+	Theme            *Theme
 	ShowClosed       bool
 	HideIsolated     bool
 	HidePRs          bool
 	HideExternalDeps bool
+	DepsDirection    dvstore.DepsDirection
+	DepsDepth        int
+	Filter           *dvstore.TaskFilter
+	AsOf             time.Time
 }
 
 func Run(h *cayley.Handle, args []string, opts RunOpts) error {
@@ -59,9 +72,14 @@ func Run(h *cayley.Handle, args []string, opts RunOpts) error {
 	if err != nil {
 		return fmt.Errorf("parse targets: %w", err)
 	}
+	if !opts.NoGraph {
+		if err := CheckClusterBy(opts.ClusterBy, opts.Format); err != nil {
+			return err
+		}
+	}
 
 	if !opts.NoPull {
-		_, err := PullAndSave(targets, h, opts.Schema, opts.GitHubToken, opts.Resync, opts.Logger)
+		_, err := PullAndSave(targets, h, opts.Schema, opts.GitHubToken, opts.Resync, opts.Snapshots, nil, opts.Logger)
 		if err != nil {
 			return fmt.Errorf("pull: %w", err)
 		}
@@ -75,7 +93,23 @@ func Run(h *cayley.Handle, args []string, opts RunOpts) error {
 			WithoutIsolated:     opts.HideIsolated,
 			WithoutPRs:          opts.HidePRs,
 			WithoutExternalDeps: opts.HideExternalDeps,
+			DepsDirection:       opts.DepsDirection,
+			DepsDepth:           opts.DepsDepth,
+			Filter:              opts.Filter,
+		}
+		if !opts.AsOf.IsZero() {
+			filters.AsOf = &opts.AsOf
+		}
+
+		// json is streamed, its cycles are computed from the dependencies of the streamed tasks; the other formats need the whole graph
+		if opts.Format == "json" {
+			it, err := dvstore.IterateTasks(h, opts.Schema, filters, opts.Logger)
+			if err != nil {
+				return fmt.Errorf("load tasks: %w", err)
+			}
+			return writeTasksJSON(os.Stdout, it)
 		}
+
 		tasks, err := dvstore.LoadTasks(h, opts.Schema, filters, opts.Logger)
 		if err != nil {
 			return fmt.Errorf("load tasks: %w", err)
@@ -83,55 +117,29 @@ func Run(h *cayley.Handle, args []string, opts RunOpts) error {
 
 		// graph
 		pertConfig := graphmanPertConfig(tasks, opts)
+		cycles := tasks.Cycles()
 
 		switch opts.Format {
-		case "json":
-			out, err := json.MarshalIndent(tasks, "", "  ")
-			if err != nil {
-				return err
-			}
-			fmt.Println(string(out))
+		case "cycles":
+			printCycles(os.Stdout, tasks, cycles)
 			return nil
 		case "graphman-pert":
-			out, err := yaml.Marshal(pertConfig)
+			return writePertConfig(os.Stdout, pertConfig, cycles)
+		case "pert-report":
+			warnCycles(opts.Logger, cycles)
+			return writePertReport(os.Stdout, pertReport(pertConfig, tasks), opts.ReportFormat)
+		case "dot", "mermaid", "plantuml", "d2":
+			graph := pertGraph(pertConfig, cycles, opts)
+			theme := opts.theme()
+			topics, err := dvstore.LoadTopics(context.TODO(), h, opts.Schema, tasks)
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(out))
-			return nil
-		case "dot":
-			// graph from PERT config
-			graph := graphman.FromPertConfig(*pertConfig)
-
-			// initialize graph from config
-			if !opts.NoPert {
-				result := graphman.ComputePert(graph)
-				shortestPath, distance := graph.FindShortestPath("Start", "Finish")
-				opts.Logger.Debug("pert result", zap.Any("result", result), zap.Int64("distance", distance))
-
-				for _, edge := range shortestPath {
-					edge.Dst().SetColor("red")
-					edge.SetColor("red")
-				}
+			now := renderTime(opts.AsOf)
+			if opts.Format != "dot" {
+				return writeDiagram(os.Stdout, newDiagram(graph, tasks, theme, topics, now, opts.Vertical), opts.Format)
 			}
 
-			// graph fine tuning
-			graph.GetVertex("Start").SetColor("blue")
-			graph.GetVertex("Finish").SetColor("blue")
-			if opts.Vertical {
-				graph.Attrs["rankdir"] = "TB"
-			}
-			graph.Attrs["overlap"] = "false"
-			graph.Attrs["pack"] = "true"
-			graph.Attrs["splines"] = "true"
-			graph.Attrs["sep"] = "0.1"
-			// graph.Attrs["layout"] = "neato"
-			// graph.Attrs["size"] = "\"11,11\""
-			// graph.Attrs["start"] = "random"
-			// FIXME: hightlight critical paths
-			// FIXME: highlight other infos
-			// FIXME: highlight target
-
 			// graphviz
 			s, err := viz.ToGraphviz(graph, &viz.Opts{
 				CommentsInLabel: true,
@@ -139,11 +147,45 @@ func Run(h *cayley.Handle, args []string, opts RunOpts) error {
 			if err != nil {
 				return fmt.Errorf("graphviz: %w", err)
 			}
+			dot, err := parseDot(s)
+			if err != nil {
+				return err
+			}
+			if err := dot.applyTheme(theme, tasks, topics, now); err != nil {
+				return fmt.Errorf("theme: %w", err)
+			}
+			if opts.ClusterBy != "" {
+				if err := dot.cluster(tasks, opts.ClusterBy); err != nil {
+					return fmt.Errorf("cluster: %w", err)
+				}
+			}
+			s = dot.String()
 
 			fmt.Println(s)
 			return nil
 		case "quads":
-			return fmt.Errorf("not implemented")
+			ctx := context.TODO()
+			topics, err := dvstore.LoadTopics(ctx, h, opts.Schema, tasks)
+			if err != nil {
+				return err
+			}
+			owners, err := dvstore.LoadOwners(ctx, h, opts.Schema, tasks, topics)
+			if err != nil {
+				return err
+			}
+			return writeSubgraphQuads(os.Stdout, opts.Schema, opts.QuadFormat, tasks, owners, topics)
+		case "cytoscape":
+			warnCycles(opts.Logger, cycles)
+			ctx := context.TODO()
+			topics, err := dvstore.LoadTopics(ctx, h, opts.Schema, tasks)
+			if err != nil {
+				return err
+			}
+			owners, err := dvstore.LoadOwners(ctx, h, opts.Schema, tasks, topics)
+			if err != nil {
+				return err
+			}
+			return writeCytoscape(os.Stdout, tasks, cycles, owners, opts.theme(), topics, renderTime(opts.AsOf))
 		default:
 			return fmt.Errorf("unsupported graph format: %q", opts.Format)
 		}
@@ -152,33 +194,174 @@ func Run(h *cayley.Handle, args []string, opts RunOpts) error {
 	return nil
 }
 
-func PullAndSave(targets []multipmuri.Entity, h *cayley.Handle, schema *schema.Config, githubToken string, resync bool, logger *zap.Logger) (bool, error) {
-	batches := pullBatches(targets, h, githubToken, resync, logger)
-	if len(batches) > 0 {
-		err := saveBatches(h, schema, batches)
+// theme returns opts.Theme, or the built-in theme if it is not set.
+func (opts RunOpts) theme() *Theme {
+	if opts.Theme == nil {
+		return DefaultTheme()
+	}
+	return opts.Theme
+}
+
+// pertGraph builds the graph of the PERT config, with the critical path and the cycles highlighted.
+func pertGraph(pertConfig *graphman.PertConfig, cycles []*dvmodel.Cycle, opts RunOpts) *graphman.Graph {
+	// graph from PERT config
+	graph := graphman.FromPertConfig(*pertConfig)
+
+	// initialize graph from config
+	if !opts.NoPert {
+		result := graphman.ComputePert(graph)
+		path, duration := criticalPath(graph)
+		opts.Logger.Debug("pert result", zap.Any("result", result), zap.Float64("duration", duration))
+
+		for _, edge := range path {
+			edge.Dst().SetColor("red")
+			edge.SetColor("red")
+		}
+	}
+	warnCycles(opts.Logger, cycles)
+	highlightCycles(graph, cycles)
+
+	// graph fine tuning
+	graph.GetVertex("Start").SetColor("blue")
+	graph.GetVertex("Finish").SetColor("blue")
+	if opts.Vertical {
+		graph.Attrs["rankdir"] = "TB"
+	}
+	graph.Attrs["overlap"] = "false"
+	graph.Attrs["pack"] = "true"
+	graph.Attrs["splines"] = "true"
+	graph.Attrs["sep"] = "0.1"
+	// graph.Attrs["layout"] = "neato"
+	// graph.Attrs["size"] = "\"11,11\""
+	// graph.Attrs["start"] = "random"
+	// FIXME: highlight target
+	return graph
+}
+
+// writeTasksJSON writes the tasks and their dependency cycles as an indented JSON object, like the Graph API output.
+//
+// The tasks are written one at a time, only their dependencies are kept to compute the cycles at the end.
+func writeTasksJSON(w io.Writer, it *dvstore.TaskIterator) error {
+	first := true
+	deps := dvmodel.Tasks{}
+	for it.Next() {
+		task := it.Task()
+		deps = append(deps, dvmodel.Task{ID: task.ID, IsDependingOn: task.IsDependingOn, IsBlocking: task.IsBlocking})
+		out, err := json.MarshalIndent(task, "    ", "  ")
 		if err != nil {
-			return false, fmt.Errorf("save batches: %w", err)
+			return err
+		}
+		prefix := ",\n    "
+		if first {
+			prefix = "{\n  \"tasks\": [\n    "
+			first = false
+		}
+		if _, err := fmt.Fprint(w, prefix, string(out)); err != nil {
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("load tasks: %w", err)
+	}
+	end := "\n  ],\n"
+	if first {
+		end = "{\n  \"tasks\": [],\n"
+	}
+	cycles, err := json.MarshalIndent(deps.Cycles(), "  ", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(w, end, "  \"cycles\": ", string(cycles), "\n}\n")
+	return err
+}
+
+// writePertConfig writes config as YAML, with the paths of the dependency cycles under an additional cycles key.
+func writePertConfig(w io.Writer, config *graphman.PertConfig, cycles []*dvmodel.Cycle) error {
+	out := struct {
+		graphman.PertConfig `yaml:",inline"`
+		Cycles              [][]string `yaml:"cycles,omitempty"`
+	}{PertConfig: *config}
+	for _, cycle := range cycles {
+		path := make([]string, len(cycle.Path))
+		for i, id := range cycle.Path {
+			path[i] = string(id)
 		}
-		return true, nil
+		out.Cycles = append(out.Cycles, path)
 	}
-	return false, nil
+	encoded, err := yaml.Marshal(out)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(encoded))
+	return err
 }
 
-func pullBatches(targets []multipmuri.Entity, h *cayley.Handle, githubToken string, resync bool, logger *zap.Logger) []dvmodel.Batch {
+// PullAndSave fetches targets and saves them, index is refreshed after each save if not nil.
+//
+// The targets that could not be fetched entirely are logged and keep their previous sync time,
+// so the next incremental sync fetches again what was missed: it only fetches what was updated
+// since the last successful sync, see pullBatches.
+func PullAndSave(targets []multipmuri.Entity, h *cayley.Handle, schema *schema.Config, githubToken string, resync bool, snapshots dvstore.SnapshotPolicy, index *dvstore.Index, logger *zap.Logger) (bool, error) {
+	startedAt := time.Now() // what is updated during the fetch is fetched again by the next sync
+	batches, fetchErrs := pullBatches(targets, h, githubToken, resync, logger)
+	changed := false
+	// batches are saved as they arrive, so a large sync never holds every entity in memory
+	for batch := range batches {
+		if err := dvstore.SaveBatches(context.TODO(), h, schema, []dvmodel.Batch{batch}, snapshots); err != nil {
+			go func() {
+				for range batches { // unblock the pending fetches
+				}
+			}()
+			return changed, fmt.Errorf("save batches: %w", err)
+		}
+		changed = true
+		ids := make([]quad.IRI, len(batch.Tasks))
+		for i, task := range batch.Tasks {
+			ids[i] = task.ID
+		}
+		if err := index.Refresh(context.TODO(), h, schema, ids); err != nil {
+			logger.Warn("refresh index", zap.Error(err))
+		}
+	}
+
+	synced := []multipmuri.Entity{}
+	for i, target := range targets {
+		if fetchErrs[i] != nil {
+			logger.Warn("fetch failed, sync time not updated", zap.String("target", target.String()), zap.Error(fetchErrs[i]))
+			continue
+		}
+		synced = append(synced, target)
+	}
+	if len(synced) == 0 {
+		return changed, nil
+	}
+	if err := dvstore.SetLastSyncedAt(context.TODO(), h, synced, startedAt); err != nil {
+		return changed, fmt.Errorf("set last sync time: %w", err)
+	}
+	return changed, nil
+}
+
+// pullBatches starts fetching targets in parallel, the returned channel is closed when every fetch is done.
+//
+// Unless resync is set, a target only fetches what was updated since its last successful sync,
+// and everything if it was never synced.
+//
+// The error of each target's fetch is stored at the same index in the returned slice, which can be read once the channel is closed.
+func pullBatches(targets []multipmuri.Entity, h *cayley.Handle, githubToken string, resync bool, logger *zap.Logger) (<-chan dvmodel.Batch, []error) {
 	// FIXME: handle the special '@me' target
 	var (
-		wg      sync.WaitGroup
-		batches = []dvmodel.Batch{}
-		out     = make(chan dvmodel.Batch)
-		ctx     = context.Background()
+		wg   sync.WaitGroup
+		out  = make(chan dvmodel.Batch)
+		errs = make([]error, len(targets))
+		ctx  = context.Background()
 	)
 
 	// parallel fetches
 	wg.Add(len(targets))
-	for _, target := range targets {
+	for i, target := range targets {
 		switch provider := target.Provider(); provider { // nolint:exhaustive
 		case multipmuri.GitHubProvider:
-			go func(repo multipmuri.Entity) {
+			go func(i int, repo multipmuri.Entity) {
 				defer wg.Done()
 
 				ghOpts := githubprovider.Opts{
@@ -187,17 +370,17 @@ func pullBatches(targets []multipmuri.Entity, h *cayley.Handle, githubToken stri
 				}
 
 				if !resync {
-					since, err := dvstore.LastUpdatedIssueInRepo(ctx, h, repo)
+					since, err := dvstore.LastSyncedAt(ctx, h, repo)
 					if err != nil {
-						logger.Warn("failed to get last updated issue", zap.Error(err))
+						logger.Warn("failed to get last sync time", zap.Error(err))
 					}
 					if !since.IsZero() && since.Unix() > 0 {
 						ghOpts.Since = &since
 					}
 				}
 
-				githubprovider.FetchRepo(ctx, repo, githubToken, out, ghOpts)
-			}(target)
+				errs[i] = githubprovider.FetchRepo(ctx, repo, githubToken, out, ghOpts)
+			}(i, target)
 		default:
 			// FIXME: clean context-based exit
 			panic(fmt.Sprintf("unsupported provider: %v", provider))
@@ -208,110 +391,5 @@ func pullBatches(targets []multipmuri.Entity, h *cayley.Handle, githubToken stri
 		close(out)
 	}()
 
-	for batch := range out {
-		batches = append(batches, batch)
-	}
-
-	return batches
-}
-
-func saveBatches(h *cayley.Handle, schema *schema.Config, batches []dvmodel.Batch) error {
-	ctx := context.TODO()
-
-	tx := cayley.NewTransaction()
-	dw := graph.NewTxWriter(tx, graph.Delete)
-	iw := graph.NewTxWriter(tx, graph.Add)
-
-	for _, batch := range batches {
-		for _, owner := range batch.Owners {
-			var working dvmodel.Owner
-			if err := schema.LoadTo(ctx, h, &working, owner.ID); err == nil {
-				_, _ = schema.WriteAsQuads(dw, working)
-			}
-
-			working = *owner
-			if _, err := schema.WriteAsQuads(iw, working); err != nil {
-				return fmt.Errorf("write as quads: %w", err)
-			}
-		}
-		for _, task := range batch.Tasks {
-			var working dvmodel.Task
-			if err := schema.LoadTo(ctx, h, &working, task.ID); err == nil {
-				_, _ = schema.WriteAsQuads(dw, working)
-			}
-
-			working = *task
-			if _, err := schema.WriteAsQuads(iw, working); err != nil {
-				return fmt.Errorf("write as quads: %w", err)
-			}
-		}
-		for _, topic := range batch.Topics {
-			var working dvmodel.Topic
-			if err := schema.LoadTo(ctx, h, &working, topic.ID); err == nil {
-				_, _ = schema.WriteAsQuads(dw, working)
-			}
-
-			working = *topic
-			if _, err := schema.WriteAsQuads(iw, working); err != nil {
-				return fmt.Errorf("write as quads: %w", err)
-			}
-		}
-	}
-
-	if err := h.ApplyTransaction(tx); err != nil {
-		return fmt.Errorf("apply tx: %w", err)
-	}
-	return nil
-}
-
-func graphmanPertConfig(tasks []dvmodel.Task, opts RunOpts) *graphman.PertConfig {
-	opts.Logger.Debug("graphTargets", zap.Int("tasks", len(tasks)), zap.Any("opts", opts))
-
-	// initialize graph config
-	config := graphman.PertConfig{
-		Actions: []graphman.PertAction{},
-		States:  []graphman.PertState{},
-	}
-	config.Opts.NoSimplify = false
-
-	// process tasks
-	for _, task := range tasks {
-		// compute dependsOn
-		dependsOn := []string{}
-		for _, dep := range task.IsDependingOn {
-			dependsOn = append(dependsOn, string(dep))
-		}
-		// FIXME: compute reverse dependsOn
-
-		switch task.Kind { // nolint:exhaustive
-		case dvmodel.Task_Issue, dvmodel.Task_MergeRequest:
-			config.Actions = append(
-				config.Actions,
-				graphman.PertAction{
-					ID:        string(task.ID),
-					Title:     task.Title,
-					DependsOn: dependsOn,
-					// FIXME: Estimate
-					// FIXME: set style based on type, active, etc
-				},
-			)
-		case dvmodel.Task_Milestone:
-			config.States = append(
-				config.States,
-				graphman.PertState{
-					ID:        string(task.ID),
-					Title:     task.Title,
-					DependsOn: dependsOn,
-					// FIXME: auto estimate (PERT)
-					// FIXME: DependsOn: milestone.DependsOn
-					// FIXME: styling
-				},
-			)
-		default:
-			opts.Logger.Warn("unsupported task kind", zap.Stringer("kind", task.Kind))
-		}
-	}
-	// FIXME: if len(unique(repos)) > 1 -> add PertState for each repo with DependsOn
-
-	return &config
+	return out, errs
 }
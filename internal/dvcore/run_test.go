@@ -2,15 +2,25 @@ package dvcore
 
 import (
 	"bytes"
+	"context"
+	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/cayleygraph/cayley"
 	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/graph/path"
 	"github.com/cayleygraph/quad"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvparser"
 	"moul.io/depviz/v3/internal/dvstore"
 	"moul.io/depviz/v3/internal/testutil"
+	"moul.io/godev"
 	"moul.io/multipmuri"
 )
 
@@ -22,7 +32,7 @@ func TestPullAndSave(t *testing.T) {
 	if githubToken == "" {
 		t.Skip("missing GITHUB_TOKEN")
 	}
-	schema := dvstore.Schema()
+	schema := schemaConfig
 	logger := testutil.Logger(t)
 
 	tests := []struct {
@@ -53,13 +63,13 @@ func TestPullAndSave(t *testing.T) {
 	for _, test := range tests {
 		store, close := dvstore.TestingStore(t)
 		defer close()
-		changed, err := PullAndSave(test.targets, store, schema, githubToken, false, logger)
+		changed, err := PullAndSave(test.targets, store, schema, githubToken, false, dvstore.SnapshotNone, nil, logger)
 		assert.NoError(t, err, test.name)
 		assert.True(t, changed, test.name)
-		changed, err = PullAndSave(test.targets, store, schema, githubToken, false, logger)
+		changed, err = PullAndSave(test.targets, store, schema, githubToken, false, dvstore.SnapshotNone, nil, logger)
 		assert.NoError(t, err, test.name)
 		assert.False(t, changed, test.name)
-		changed, err = PullAndSave(test.targets, store, schema, githubToken, true, logger)
+		changed, err = PullAndSave(test.targets, store, schema, githubToken, true, dvstore.SnapshotNone, nil, logger)
 		assert.NoError(t, err, test.name)
 		assert.True(t, changed, test.name)
 
@@ -75,9 +85,21 @@ func TestPullAndSave(t *testing.T) {
 		assert.NotNil(t, qw, test.name)
 		defer qw.Close()
 
-		n, err := quad.Copy(qw, qr)
+		// skip sync times, they change on every run
+		n := 0
+		for {
+			q, err := qr.ReadQuad()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err, test.name)
+			if q.Predicate == dvstore.LastSyncedAtPredicate {
+				continue
+			}
+			assert.NoError(t, qw.WriteQuad(q), test.name)
+			n++
+		}
 		assert.Greater(t, n, 0, test.name)
-		assert.NoError(t, err, test.name)
 
 		gp := dvstore.TestingGoldenDumpPath(t, test.name)
 		if testutil.UpdateGolden() {
@@ -91,3 +113,164 @@ func TestPullAndSave(t *testing.T) {
 		assert.Equal(t, string(g), b.String())
 	}
 }
+
+func TestPullAndSaveFetchErrors(t *testing.T) {
+	store, close := dvstore.TestingStore(t)
+	defer close()
+
+	// owners cannot be fetched (yet), the fetch fails before any request
+	target := multipmuri.NewGitHubOwner("github.com", "moul")
+	changed, err := PullAndSave([]multipmuri.Entity{target}, store, schemaConfig, "", false, dvstore.SnapshotNone, nil, testutil.Logger(t))
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	syncs, err := path.StartPath(store, quad.IRI(target.String())).Out(dvstore.LastSyncedAtPredicate).Iterate(context.Background()).AllValues(store)
+	require.NoError(t, err)
+	assert.Empty(t, syncs)
+}
+
+func TestSaveBatchesSnapshots(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	golden, closeGolden := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeGolden()
+	batch, err := GetStoreDump(ctx, golden, schemaConfig)
+	require.NoError(t, err)
+
+	store, close := dvstore.TestingStore(t)
+	defer close()
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{*batch}, dvstore.SnapshotDaily))
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{*batch}, dvstore.SnapshotDaily))
+
+	revisions, err := path.StartPath(store).Out(dvstore.RevisionPredicate).Iterate(ctx).AllValues(store)
+	require.NoError(t, err)
+	assert.Len(t, revisions, len(batch.Tasks))
+
+	// the latest snapshot matches the current state, for every kind of target
+	for _, targets := range [][]string{
+		nil,
+		{"moul/depviz-test"},
+		{"moul/depviz-test#7"},
+		{"https://github.com/moul/depviz-test/milestone/1"},
+		{"moul/depviz-test/labels/bug"},
+		{"@moul-bot"},
+	} {
+		filters := dvstore.LoadTasksFilters{TheWorld: targets == nil, WithClosed: true}
+		filters.Targets, err = dvparser.ParseTargets(targets)
+		require.NoError(t, err)
+		current, err := dvstore.LoadTasks(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		assert.NotEmpty(t, current, targets)
+		now := time.Now()
+		filters.AsOf = &now
+		asOf, err := dvstore.LoadTasks(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		assert.Equal(t, relationsJSON(current), relationsJSON(asOf), targets)
+	}
+}
+
+// relationsJSON returns tasks as JSON, with sorted relationships: the order of the inverse ones depends on the store.
+func relationsJSON(tasks dvmodel.Tasks) string {
+	for _, task := range tasks {
+		for _, iris := range [][]quad.IRI{task.IsDependingOn, task.IsBlocking, task.IsRelatedWith, task.IsPartOf, task.HasPart} {
+			sort.Slice(iris, func(i, j int) bool { return iris[i] < iris[j] })
+		}
+	}
+	return godev.JSON(tasks)
+}
+
+func TestSaveBatchesSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	golden, closeGolden := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeGolden()
+	batch, err := GetStoreDump(ctx, golden, schemaConfig)
+	require.NoError(t, err)
+
+	store, close := dvstore.TestingStore(t)
+	defer close()
+	search := func(query string) int {
+		results, err := dvstore.Search(ctx, store, schemaConfig, query, dvstore.SearchOpts{WithClosed: true})
+		require.NoError(t, err)
+		return len(results)
+	}
+
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{*batch}, dvstore.SnapshotNone))
+	assert.Equal(t, 1, search("standard"))
+
+	// renaming a task updates its terms, without corrupting the store
+	for _, task := range batch.Tasks {
+		if task.Title == "I'm a standard issue" {
+			task.Title = "renamed"
+		}
+	}
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{*batch}, dvstore.SnapshotNone))
+	assert.Equal(t, 0, search("standard"))
+	assert.Equal(t, 1, search("renamed"))
+	problems, err := dvstore.Diagnose(ctx, store)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestSaveBatchesInverses(t *testing.T) {
+	ctx := context.Background()
+	golden, closeGolden := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeGolden()
+	batch, err := GetStoreDump(ctx, golden, schemaConfig)
+	require.NoError(t, err)
+
+	var (
+		issue4  = quad.IRI("https://github.com/moul/depviz-test/issues/4")
+		issue10 = quad.IRI("https://github.com/moul/depviz-test/issues/10")
+		issue5  = quad.IRI("https://github.com/moul/depviz-test/issues/5")
+	)
+	load := func(h *cayley.Handle, id quad.IRI) dvmodel.Task {
+		var task dvmodel.Task
+		require.NoError(t, schemaConfig.LoadTo(ctx, h, &task, id))
+		return task
+	}
+	checkStore := func(h *cayley.Handle) {
+		problems, err := dvstore.Diagnose(ctx, h)
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+	}
+
+	// #4 is not declaring anything, #5 and #10 are depending on it
+	store, close := dvstore.TestingStore(t)
+	defer close()
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{*batch}, dvstore.SnapshotNone))
+	assert.ElementsMatch(t, []quad.IRI{issue5, issue10}, load(store, issue4).IsBlocking)
+	assert.Contains(t, load(store, issue10).IsDependingOn, issue4)
+	checkStore(store)
+
+	// dumps only contain the explicit relationships
+	dump, err := GetStoreDump(ctx, store, schemaConfig)
+	require.NoError(t, err)
+	assert.Equal(t, godev.JSON(batch), godev.JSON(dump))
+
+	// removing the original relationship removes its inverse
+	for _, task := range batch.Tasks {
+		if task.ID == issue10 {
+			task.IsDependingOn = []quad.IRI{issue5}
+		}
+	}
+	require.NoError(t, dvstore.SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{*batch}, dvstore.SnapshotNone))
+	assert.Equal(t, []quad.IRI{issue5}, load(store, issue4).IsBlocking)
+	checkStore(store)
+
+	// a task saved after the tasks depending on it gets the inverses too
+	other, closeOther := dvstore.TestingStore(t)
+	defer closeOther()
+	first := dvmodel.Batch{Owners: batch.Owners, Topics: batch.Topics}
+	second := dvmodel.Batch{}
+	for _, task := range batch.Tasks {
+		if task.ID == issue4 {
+			second.Tasks = append(second.Tasks, task)
+		} else {
+			first.Tasks = append(first.Tasks, task)
+		}
+	}
+	require.NoError(t, dvstore.SaveBatches(ctx, other, schemaConfig, []dvmodel.Batch{first}, dvstore.SnapshotNone))
+	require.NoError(t, dvstore.SaveBatches(ctx, other, schemaConfig, []dvmodel.Batch{second}, dvstore.SnapshotNone))
+	assert.Equal(t, []quad.IRI{issue5}, load(other, issue4).IsBlocking)
+	checkStore(other)
+}
@@ -0,0 +1,71 @@
+package dvcore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/schema"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvstore"
+)
+
+type SearchOpts struct {
+	Limit      int
+	WithClosed bool
+	JSON       bool
+	Logger     *zap.Logger
+	Schema     *schema.Config
+}
+
+// Search prints the tasks matching the query built from args, best matches first.
+func Search(h *cayley.Handle, args []string, opts SearchOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	opts.Logger.Debug("Search called", zap.Strings("args", args), zap.Any("opts", opts))
+
+	searchOpts := dvstore.SearchOpts{
+		Limit:      opts.Limit,
+		WithClosed: opts.WithClosed,
+	}
+	if !opts.JSON {
+		searchOpts.HighlightPre, searchOpts.HighlightPost = "**", "**"
+	}
+	results, err := dvstore.Search(context.TODO(), h, opts.Schema, strings.Join(args, " "), searchOpts)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		out, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	printSearchResults(os.Stdout, results)
+	return nil
+}
+
+func printSearchResults(w io.Writer, results []dvstore.SearchResult) {
+	if len(results) == 0 {
+		fmt.Fprintln(w, "no results")
+		return
+	}
+	for _, result := range results {
+		id := result.Task.LocalID
+		if id == "" {
+			id = string(result.Task.ID)
+		}
+		fmt.Fprintf(w, "%s  %s  (%.1f)\n", id, result.Task.Title, result.Score)
+		for _, highlight := range result.Highlights {
+			fmt.Fprintf(w, "    %-11s %s\n", highlight.Field+":", highlight.Fragment)
+		}
+	}
+}
@@ -2,21 +2,178 @@ package dvcore
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
 	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"go.uber.org/zap"
 	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvstore"
+	"moul.io/multipmuri"
 )
 
-func StoreDumpQuads(h *cayley.Handle) error {
-	fmt.Println("quads:")
-	ctx := context.Background()
-	it := h.QuadsAllIterator()
-	for it.Next(ctx) {
-		fmt.Println(h.Quad(it.Result()))
+const defaultQuadFormat = "nquads"
+
+type StoreDumpOpts struct {
+	// Path is the destination file, stdout is used if empty or "-".
+	Path string
+	// Format is a registered cayley quad format, guessed from Path's extension if empty.
+	Format string
+}
+
+type StoreRestoreOpts struct {
+	// Path is the source file, stdin is used if empty or "-".
+	Path string
+	// Format is a registered cayley quad format, guessed from Path's extension if empty.
+	Format string
+	// Replace swaps the existing quads with the restored ones, see dvstore.ReplaceQuads, instead of merging with them.
+	Replace bool
+}
+
+func StoreDumpQuads(h *cayley.Handle, opts StoreDumpOpts) (err error) {
+	format, err := quadFormat(opts.Format, opts.Path)
+	if err != nil {
+		return err
+	}
+	if format.Writer == nil {
+		return fmt.Errorf("format %q does not support writing", format.Name)
 	}
 
+	out, closeFunc, err := openOutput(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := closeFunc(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	qw := format.Writer(out)
+	qr := graph.NewQuadStoreReader(h.QuadStore)
+	defer qr.Close()
+
+	if _, err := quad.Copy(qw, qr); err != nil {
+		_ = qw.Close()
+		return fmt.Errorf("copy quads: %w", err)
+	}
+	if err := qw.Close(); err != nil {
+		return fmt.Errorf("close %s writer: %w", format.Name, err)
+	}
+	return nil
+}
+
+// StoreRestoreQuads reads every quad of the input before writing them, so an invalid input leaves the store untouched.
+func StoreRestoreQuads(h *cayley.Handle, opts StoreRestoreOpts) error {
+	format, err := quadFormat(opts.Format, opts.Path)
+	if err != nil {
+		return err
+	}
+	if format.Reader == nil {
+		return fmt.Errorf("format %q does not support reading", format.Name)
+	}
+
+	in, closeFunc, err := openInput(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	qr := format.Reader(in)
+	defer qr.Close()
+	quads, err := quad.ReadAll(qr)
+	if err != nil {
+		return fmt.Errorf("read quads: %w", err)
+	}
+
+	if opts.Replace {
+		if err := dvstore.ReplaceQuads(context.TODO(), h, quads); err != nil {
+			return fmt.Errorf("replace quads: %w", err)
+		}
+		return nil
+	}
+
+	qw := graph.NewWriter(h.QuadWriter)
+	if _, err := qw.WriteQuads(quads); err != nil {
+		_ = qw.Close()
+		return fmt.Errorf("write quads: %w", err)
+	}
+	if err := qw.Close(); err != nil {
+		return fmt.Errorf("flush quads: %w", err)
+	}
+	return nil
+}
+
+func StoreDumpJSON(ctx context.Context, h *cayley.Handle, schema *schema.Config, opts StoreDumpOpts) (err error) {
+	batch, err := GetStoreDump(ctx, h, schema)
+	if err != nil {
+		return fmt.Errorf("get store dump: %w", err)
+	}
+
+	out, closeFunc, err := openOutput(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := closeFunc(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(batch); err != nil {
+		return fmt.Errorf("encode json: %w", err)
+	}
+	return nil
+}
+
+// StoreRestoreJSON decodes the whole input before writing it, so an invalid input leaves the store untouched.
+func StoreRestoreJSON(h *cayley.Handle, schema *schema.Config, opts StoreRestoreOpts) error {
+	in, closeFunc, err := openInput(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	var batch dvmodel.Batch
+	if err := json.NewDecoder(in).Decode(&batch); err != nil {
+		return fmt.Errorf("decode json: %w", err)
+	}
+
+	if !opts.Replace {
+		if err := dvstore.SaveBatches(context.TODO(), h, schema, []dvmodel.Batch{batch}, dvstore.SnapshotNone); err != nil {
+			return fmt.Errorf("save batches: %w", err)
+		}
+		return nil
+	}
+
+	// the batch is saved in memory first, then swapped with the content of the store
+	mem, err := dvstore.OpenStore(dvstore.StoreConfig{Backend: "memstore"})
+	if err != nil {
+		return err
+	}
+	defer mem.Close()
+	if err := dvstore.SaveBatches(context.TODO(), mem, schema, []dvmodel.Batch{batch}, dvstore.SnapshotNone); err != nil {
+		return fmt.Errorf("save batches: %w", err)
+	}
+	quads, err := quad.ReadAll(graph.NewQuadStoreReader(mem.QuadStore))
+	if err != nil {
+		return fmt.Errorf("read quads: %w", err)
+	}
+	if err := dvstore.ReplaceQuads(context.TODO(), h, quads); err != nil {
+		return fmt.Errorf("replace quads: %w", err)
+	}
 	return nil
 }
 
@@ -47,6 +204,10 @@ func GetStoreDump(ctx context.Context, h *cayley.Handle, schema *schema.Config)
 		clone := task
 		dump.Tasks[idx] = &clone
 	}
+	// inverse relationships are materialised again when restoring
+	if err := dvstore.ExplicitRelations(ctx, h, dump.Tasks); err != nil {
+		return nil, fmt.Errorf("load relationships: %w", err)
+	}
 	for idx, topic := range topics {
 		clone := topic
 		dump.Topics[idx] = &clone
@@ -55,13 +216,453 @@ func GetStoreDump(ctx context.Context, h *cayley.Handle, schema *schema.Config)
 	return &dump, nil
 }
 
-func StoreInfo(h *cayley.Handle) error {
-	fmt.Println(h)
-	// FIXME: amount of quads
-	// FIXME: amount of owners, tasks, topics
-	// FIXME: amount of relationships
-	// FIXME: last refresh
-	// FIXME: db size
-	// FIXME: db location
-	return fmt.Errorf("not implemented")
+type StoreInfoOpts struct {
+	// Backend is the name of the cayley backend.
+	Backend string
+	// Location is where the store lives, used to compute its size when it is on disk.
+	Location string
+	// JSON prints the report as JSON instead of a human readable summary.
+	JSON bool
+}
+
+func StoreInfo(ctx context.Context, h *cayley.Handle, schema *schema.Config, opts StoreInfoOpts) error {
+	info, err := GetStoreInfo(ctx, h, schema, opts)
+	if err != nil {
+		return fmt.Errorf("get store info: %w", err)
+	}
+
+	if opts.JSON {
+		out, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	return printStoreInfo(os.Stdout, info)
+}
+
+func GetStoreInfo(ctx context.Context, h *cayley.Handle, schema *schema.Config, opts StoreInfoOpts) (*dvmodel.StoreInfo, error) {
+	info := dvmodel.StoreInfo{
+		Backend:       opts.Backend,
+		Location:      opts.Location,
+		Relationships: map[string]int64{},
+	}
+	if opts.Location != "" {
+		size, err := diskUsage(opts.Location)
+		if err == nil {
+			info.SizeBytes = size
+		}
+	}
+
+	// single pass over every quad
+	var (
+		types      = map[quad.IRI]quad.IRI{}
+		syncs      = map[quad.IRI]time.Time{}
+		edges      = []*dvmodel.StoreInfo_Reference{}
+		isRelation = map[quad.IRI]bool{}
+	)
+	for _, predicate := range dvstore.RelationshipPredicates {
+		isRelation[predicate] = true
+	}
+	it := h.QuadsAllIterator()
+	defer it.Close()
+	for it.Next(ctx) {
+		info.Quads++
+		q := h.Quad(it.Result())
+		subject, ok := q.Subject.(quad.IRI)
+		if !ok {
+			continue
+		}
+		predicate, _ := q.Predicate.(quad.IRI)
+		switch {
+		case predicate == "rdf:type":
+			if object, ok := q.Object.(quad.IRI); ok {
+				types[subject] = object
+			}
+		case predicate == dvstore.LastSyncedAtPredicate:
+			if at, ok := quad.NativeOf(q.Object).(time.Time); ok && at.After(syncs[subject]) {
+				syncs[subject] = at
+			}
+		case isRelation[predicate] && !dvstore.IsInverseQuad(q): // only the relationships declared by the tasks
+			info.Relationships[string(predicate)]++
+			if object, ok := q.Object.(quad.IRI); ok {
+				edges = append(edges, &dvmodel.StoreInfo_Reference{Subject: subject, Predicate: predicate, Object: object})
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate quads: %w", err)
+	}
+
+	for _, kind := range types {
+		switch kind {
+		case "dv:Owner":
+			info.Owners++
+		case "dv:Task":
+			info.Tasks++
+		case "dv:Topic":
+			info.Topics++
+		}
+	}
+
+	for _, edge := range edges {
+		if _, found := types[edge.Object]; !found {
+			info.DanglingReferences = append(info.DanglingReferences, edge)
+		}
+	}
+	sort.Slice(info.DanglingReferences, func(i, j int) bool {
+		a, b := info.DanglingReferences[i], info.DanglingReferences[j]
+		if a.Subject != b.Subject {
+			return a.Subject < b.Subject
+		}
+		if a.Predicate != b.Predicate {
+			return a.Predicate < b.Predicate
+		}
+		return a.Object < b.Object
+	})
+
+	version, err := dvstore.StoreSchemaVersion(ctx, h)
+	if err != nil {
+		return nil, fmt.Errorf("get schema version: %w", err)
+	}
+	info.SchemaVersion = int32(version)
+
+	// per-repo stats
+	tasks := []dvmodel.Task{}
+	if err := schema.LoadTo(ctx, h, &tasks); err != nil {
+		return nil, fmt.Errorf("load tasks: %w", err)
+	}
+	repos := map[quad.IRI]*dvmodel.StoreInfo_Repo{}
+	getRepo := func(id quad.IRI) *dvmodel.StoreInfo_Repo {
+		repo, found := repos[id]
+		if !found {
+			repo = &dvmodel.StoreInfo_Repo{
+				ID:           id,
+				TasksByKind:  map[string]int64{},
+				TasksByState: map[string]int64{},
+			}
+			repos[id] = repo
+		}
+		return repo
+	}
+	for _, task := range tasks {
+		repo := getRepo(task.HasOwner)
+		repo.Tasks++
+		repo.TasksByKind[task.Kind.String()]++
+		repo.TasksByState[task.State.String()]++
+		if task.UpdatedAt != nil && (repo.LastUpdatedAt == nil || task.UpdatedAt.After(*repo.LastUpdatedAt)) {
+			updatedAt := *task.UpdatedAt
+			repo.LastUpdatedAt = &updatedAt
+		}
+	}
+	for id, at := range syncs {
+		syncedAt := at
+		getRepo(id).LastSyncedAt = &syncedAt
+	}
+	for _, repo := range repos {
+		info.Repos = append(info.Repos, repo)
+		if repo.LastUpdatedAt != nil && (info.LastUpdatedAt == nil || repo.LastUpdatedAt.After(*info.LastUpdatedAt)) {
+			info.LastUpdatedAt = repo.LastUpdatedAt
+		}
+		if repo.LastSyncedAt != nil && (info.LastSyncedAt == nil || repo.LastSyncedAt.After(*info.LastSyncedAt)) {
+			info.LastSyncedAt = repo.LastSyncedAt
+		}
+	}
+	sort.Slice(info.Repos, func(i, j int) bool {
+		return info.Repos[i].ID < info.Repos[j].ID
+	})
+
+	return &info, nil
+}
+
+func printStoreInfo(w io.Writer, info *dvmodel.StoreInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "backend:\t%s\n", info.Backend)
+	if info.Location != "" {
+		fmt.Fprintf(tw, "location:\t%s\n", info.Location)
+	}
+	if info.SizeBytes > 0 {
+		fmt.Fprintf(tw, "size:\t%s\n", humanBytes(info.SizeBytes))
+	}
+	fmt.Fprintf(tw, "schema version:\tv%d (current: v%d)\n", info.SchemaVersion, dvstore.SchemaVersion)
+	fmt.Fprintf(tw, "quads:\t%d\n", info.Quads)
+	fmt.Fprintf(tw, "owners:\t%d\n", info.Owners)
+	fmt.Fprintf(tw, "tasks:\t%d\n", info.Tasks)
+	fmt.Fprintf(tw, "topics:\t%d\n", info.Topics)
+	fmt.Fprintf(tw, "last update:\t%s\n", formatOptionalTime(info.LastUpdatedAt))
+	fmt.Fprintf(tw, "last sync:\t%s\n", formatOptionalTime(info.LastSyncedAt))
+
+	if len(info.Relationships) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintln(tw, "relationships:")
+		for _, predicate := range sortedKeys(info.Relationships) {
+			fmt.Fprintf(tw, "  %s\t%d\n", predicate, info.Relationships[predicate])
+		}
+	}
+
+	if len(info.Repos) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintln(tw, "repos:")
+		for _, repo := range info.Repos {
+			fmt.Fprintf(tw, "  %s\t%d tasks\t%s\t%s\tupdated: %s\tsynced: %s\n",
+				string(repo.ID),
+				repo.Tasks,
+				formatCounts(repo.TasksByKind),
+				formatCounts(repo.TasksByState),
+				formatOptionalTime(repo.LastUpdatedAt),
+				formatOptionalTime(repo.LastSyncedAt),
+			)
+		}
+	}
+
+	if len(info.DanglingReferences) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintf(tw, "dangling references (%d):\n", len(info.DanglingReferences))
+		for _, ref := range info.DanglingReferences {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", string(ref.Subject), string(ref.Predicate), string(ref.Object))
+		}
+	}
+
+	return tw.Flush()
+}
+
+func formatCounts(counts map[string]int64) string {
+	parts := []string{}
+	for _, key := range sortedKeys(counts) {
+		parts = append(parts, fmt.Sprintf("%s=%d", key, counts[key]))
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func humanBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func diskUsage(location string) (int64, error) {
+	var size int64
+	err := filepath.Walk(location, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+type StoreMigrateOpts struct {
+	// DryRun only prints the pending migrations.
+	DryRun bool
+	Logger *zap.Logger
+}
+
+func StoreMigrate(ctx context.Context, h *cayley.Handle, opts StoreMigrateOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	version, err := dvstore.StoreSchemaVersion(ctx, h)
+	if err != nil {
+		return fmt.Errorf("get schema version: %w", err)
+	}
+	pending := dvstore.PendingMigrations(version)
+	if version == dvstore.EmptySchemaVersion {
+		pending = nil
+	}
+
+	fmt.Printf("store schema version: v%d (current: v%d)\n", version, dvstore.SchemaVersion)
+	for _, migration := range pending {
+		fmt.Printf("pending migration: v%d: %s\n", migration.Version, migration.Description)
+	}
+	if opts.DryRun || version == dvstore.SchemaVersion {
+		return nil
+	}
+
+	from, to, err := dvstore.Migrate(ctx, h, opts.Logger)
+	if err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+	fmt.Printf("store migrated from v%d to v%d\n", from, to)
+	return nil
+}
+
+type StoreDoctorOpts struct {
+	// Fix repairs the problems found, see dvstore.Repair.
+	Fix    bool
+	Logger *zap.Logger
+}
+
+func StoreDoctor(ctx context.Context, h *cayley.Handle, opts StoreDoctorOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	problems, err := dvstore.Diagnose(ctx, h)
+	if err != nil {
+		return fmt.Errorf("diagnose: %w", err)
+	}
+	if len(problems) == 0 {
+		fmt.Println("no problem found")
+		return nil
+	}
+
+	counts := map[dvstore.ProblemKind]int{}
+	repairable := 0
+	for _, problem := range problems {
+		counts[problem.Kind]++
+		if problem.Repairable() {
+			repairable++
+		}
+		fmt.Println(problem.String())
+	}
+	fmt.Println()
+	for _, kind := range dvstore.ProblemKinds {
+		if counts[kind] > 0 {
+			fmt.Printf("%s: %d\n", kind, counts[kind])
+		}
+	}
+	fmt.Printf("%d problems found, %d repairable\n", len(problems), repairable)
+
+	if !opts.Fix {
+		if repairable > 0 {
+			fmt.Println("run with --fix to repair them")
+		}
+		return nil
+	}
+
+	repaired, err := dvstore.Repair(ctx, h, problems)
+	if err != nil {
+		return fmt.Errorf("repair: %w", err)
+	}
+	opts.Logger.Debug("store repaired", zap.Int("problems", repaired))
+	fmt.Printf("%d problems repaired\n", repaired)
+	return nil
+}
+
+type StorePruneOpts struct {
+	// ClosedOlderThan drops the closed tasks without activity for that long, 0 disables the policy.
+	ClosedOlderThan     time.Duration
+	UnconfiguredTargets bool
+	Targets             []multipmuri.Entity
+	Orphans             bool
+	DryRun              bool
+	Logger              *zap.Logger
+}
+
+func StorePrune(ctx context.Context, h *cayley.Handle, opts StorePruneOpts) error {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.ClosedOlderThan == 0 && !opts.UnconfiguredTargets && !opts.Orphans {
+		return fmt.Errorf("no retention policy enabled")
+	}
+
+	report, err := dvstore.Prune(ctx, h, dvstore.PruneOpts{
+		ClosedOlderThan:     opts.ClosedOlderThan,
+		UnconfiguredTargets: opts.UnconfiguredTargets,
+		Targets:             opts.Targets,
+		Orphans:             opts.Orphans,
+		DryRun:              opts.DryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("prune: %w", err)
+	}
+	opts.Logger.Debug("store pruned", zap.Int("entities", report.Entities()), zap.Int("quads", report.Quads), zap.Bool("dry-run", opts.DryRun))
+
+	for _, section := range []struct {
+		title string
+		iris  []quad.IRI
+	}{
+		{"closed tasks", report.ClosedTasks},
+		{"unconfigured repos", report.UnconfiguredRepos},
+		{"tasks of unconfigured repos", report.UnconfiguredTasks},
+		{"orphans", report.Orphans},
+	} {
+		if len(section.iris) == 0 {
+			continue
+		}
+		fmt.Printf("%s (%d):\n", section.title, len(section.iris))
+		for _, iri := range section.iris {
+			fmt.Printf("  %s\n", string(iri))
+		}
+	}
+	if opts.DryRun {
+		fmt.Printf("would remove %d entities (%d quads)\n", report.Entities(), report.Quads)
+	} else {
+		fmt.Printf("removed %d entities (%d quads)\n", report.Entities(), report.Quads)
+	}
+	return nil
+}
+
+func quadFormat(name string, path string) (*quad.Format, error) {
+	if name == "" && path != "" && path != "-" {
+		if format := quad.FormatByExt(filepath.Ext(path)); format != nil {
+			return format, nil
+		}
+	}
+	if name == "" {
+		name = defaultQuadFormat
+	}
+	format := quad.FormatByName(name)
+	if format == nil {
+		return nil, fmt.Errorf("unsupported quad format: %q", name)
+	}
+	return format, nil
+}
+
+// openOutput returns the destination of a dump and a function closing it, which reports the errors of the last writes.
+func openOutput(path string) (io.Writer, func() error, error) {
+	if path == "" || path == "-" {
+		return os.Stdout, func() error { return nil }, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create %q: %w", path, err)
+	}
+	return f, func() error {
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("close %q: %w", path, err)
+		}
+		return nil
+	}, nil
+}
+
+func openInput(path string) (io.Reader, func(), error) {
+	if path == "" || path == "-" {
+		return os.Stdin, func() {}, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open %q: %w", path, err)
+	}
+	return f, func() { _ = f.Close() }, nil
 }
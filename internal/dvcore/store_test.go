@@ -0,0 +1,226 @@
+package dvcore
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvparser"
+	"moul.io/depviz/v3/internal/dvstore"
+)
+
+var schemaConfig *schema.Config
+
+func init() {
+	schemaConfig = dvstore.Schema()
+}
+
+func TestStoreDumpRestoreQuads(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		path     string
+		lossless bool
+	}{
+		{"nquads", "nquads", "dump", true},
+		{"pquads", "pquads", "dump", true},
+		{"json", "json", "dump", true},
+		// JSON-LD only keeps predicates that are absolute IRIs (drops hasOwner, isDependingOn, etc)
+		{"jsonld", "jsonld", "dump", false},
+		{"nquads-by-ext", "", "dump.nq", true},
+		{"pquads-by-ext", "", "dump.pq", true},
+	}
+
+	src, closeSrc := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeSrc()
+	expected := countQuads(t, src)
+
+	dir, err := ioutil.TempDir("", "depviz")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name+"-"+test.path)
+		err := StoreDumpQuads(src, StoreDumpOpts{Path: path, Format: test.format})
+		require.NoError(t, err, test.name)
+
+		dst, closeDst := dvstore.TestingStore(t)
+		err = StoreRestoreQuads(dst, StoreRestoreOpts{Path: path, Format: test.format})
+		assert.NoError(t, err, test.name)
+		if test.lossless {
+			assert.Equal(t, expected, countQuads(t, dst), test.name)
+		} else {
+			assert.Greater(t, countQuads(t, dst), 0, test.name)
+		}
+		closeDst()
+	}
+}
+
+func TestStoreRestoreQuadsModes(t *testing.T) {
+	src, closeSrc := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeSrc()
+	expected := countQuads(t, src)
+
+	dir, err := ioutil.TempDir("", "depviz")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dump.nq")
+	require.NoError(t, StoreDumpQuads(src, StoreDumpOpts{Path: path}))
+
+	extra := quad.Make(quad.IRI("https://example.com/foo"), quad.IRI("rdf:type"), quad.IRI("dv:Owner"), nil)
+
+	{ // merge
+		dst, closeDst := dvstore.TestingStore(t)
+		require.NoError(t, dst.AddQuad(extra))
+		assert.NoError(t, StoreRestoreQuads(dst, StoreRestoreOpts{Path: path}))
+		assert.Equal(t, expected+1, countQuads(t, dst))
+
+		// restoring twice does not duplicate anything
+		assert.NoError(t, StoreRestoreQuads(dst, StoreRestoreOpts{Path: path}))
+		assert.Equal(t, expected+1, countQuads(t, dst))
+		closeDst()
+	}
+
+	{ // replace
+		dst, closeDst := dvstore.TestingStore(t)
+		require.NoError(t, dst.AddQuad(extra))
+		assert.NoError(t, StoreRestoreQuads(dst, StoreRestoreOpts{Path: path, Replace: true}))
+		assert.Equal(t, expected, countQuads(t, dst))
+		closeDst()
+	}
+
+	{ // invalid inputs do not touch the store
+		truncated := filepath.Join(dir, "truncated.nq")
+		content, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(truncated, content[:len(content)/2], 0o600))
+		invalidJSON := filepath.Join(dir, "invalid.json")
+		require.NoError(t, ioutil.WriteFile(invalidJSON, []byte(`{"tasks": [`), 0o600))
+
+		dst, closeDst := dvstore.TestingGoldenStore(t, "all-depviz-test")
+		assert.Error(t, StoreRestoreQuads(dst, StoreRestoreOpts{Path: truncated, Replace: true}))
+		assert.Error(t, StoreRestoreQuads(dst, StoreRestoreOpts{Path: filepath.Join(dir, "missing.nq"), Replace: true}))
+		assert.Error(t, StoreRestoreJSON(dst, schemaConfig, StoreRestoreOpts{Path: invalidJSON, Replace: true}))
+		assert.Equal(t, expected, countQuads(t, dst))
+		closeDst()
+	}
+}
+
+func TestStoreDumpRestoreJSON(t *testing.T) {
+	ctx := context.Background()
+	schema := schemaConfig
+
+	src, closeSrc := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeSrc()
+
+	dir, err := ioutil.TempDir("", "depviz")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dump.json")
+	require.NoError(t, StoreDumpJSON(ctx, src, schema, StoreDumpOpts{Path: path}))
+
+	dst, closeDst := dvstore.TestingStore(t)
+	defer closeDst()
+	extra := quad.Make(quad.IRI("https://example.com/foo"), quad.IRI("rdf:type"), quad.IRI("dv:Owner"), nil)
+	require.NoError(t, dst.AddQuad(extra))
+	require.NoError(t, StoreRestoreJSON(dst, schema, StoreRestoreOpts{Path: path, Replace: true}))
+
+	expected, err := GetStoreDump(ctx, src, schema)
+	require.NoError(t, err)
+	actual, err := GetStoreDump(ctx, dst, schema)
+	require.NoError(t, err)
+	assert.Equal(t, len(expected.Owners), len(actual.Owners))
+	assert.Equal(t, len(expected.Tasks), len(actual.Tasks))
+	assert.Equal(t, len(expected.Topics), len(actual.Topics))
+}
+
+func TestQuadFormat(t *testing.T) {
+	format, err := quadFormat("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "nquads", format.Name)
+
+	format, err = quadFormat("", "foo.jsonld")
+	assert.NoError(t, err)
+	assert.Equal(t, "jsonld", format.Name)
+
+	format, err = quadFormat("pquads", "foo.jsonld")
+	assert.NoError(t, err)
+	assert.Equal(t, "pquads", format.Name)
+
+	_, err = quadFormat("invalid", "")
+	assert.Error(t, err)
+}
+
+func countQuads(t *testing.T, h *cayley.Handle) int {
+	t.Helper()
+	ctx := context.Background()
+	it := h.QuadsAllIterator()
+	defer it.Close()
+	count := 0
+	for it.Next(ctx) {
+		count++
+	}
+	return count
+}
+
+func TestGetStoreInfo(t *testing.T) {
+	ctx := context.Background()
+	schema := schemaConfig
+
+	store, closeStore := dvstore.TestingGoldenStore(t, "all-depviz-test")
+	defer closeStore()
+
+	info, err := GetStoreInfo(ctx, store, schema, StoreInfoOpts{Backend: "bolt"})
+	require.NoError(t, err)
+	assert.Equal(t, "bolt", info.Backend)
+	assert.Equal(t, int64(countQuads(t, store)), info.Quads)
+	assert.Greater(t, info.Owners, int64(0))
+	assert.Greater(t, info.Tasks, int64(0))
+	assert.Greater(t, info.Relationships["hasOwner"], int64(0))
+	assert.NotNil(t, info.LastUpdatedAt)
+	assert.Nil(t, info.LastSyncedAt)
+
+	var repoTasks int64
+	for _, repo := range info.Repos {
+		repoTasks += repo.Tasks
+	}
+	assert.Equal(t, info.Tasks, repoTasks)
+
+	for _, ref := range info.DanglingReferences {
+		assert.NotEmpty(t, ref.Object)
+	}
+
+	// the materialised inverse relationships are not counted
+	_, _, err = dvstore.Migrate(ctx, store, zap.NewNop())
+	require.NoError(t, err)
+	migrated, err := GetStoreInfo(ctx, store, schema, StoreInfoOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, info.Relationships, migrated.Relationships)
+	assert.Equal(t, info.DanglingReferences, migrated.DanglingReferences)
+
+	// sync times are reported per target
+	targets, err := dvparser.ParseTargets([]string{"moul/depviz-test"})
+	require.NoError(t, err)
+	syncedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, dvstore.SetLastSyncedAt(ctx, store, targets, syncedAt))
+	require.NoError(t, dvstore.SetLastSyncedAt(ctx, store, targets, syncedAt.Add(time.Hour)))
+
+	info, err = GetStoreInfo(ctx, store, schema, StoreInfoOpts{})
+	require.NoError(t, err)
+	require.NotNil(t, info.LastSyncedAt)
+	assert.True(t, info.LastSyncedAt.Equal(syncedAt.Add(time.Hour)))
+
+	var b bytes.Buffer
+	assert.NoError(t, printStoreInfo(&b, info))
+	assert.Contains(t, b.String(), "https://github.com/moul/depviz-test")
+}
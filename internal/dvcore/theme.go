@@ -0,0 +1,302 @@
+package dvcore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	str2duration "github.com/xhit/go-str2duration/v2"
+	yaml "gopkg.in/yaml.v2"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvstore"
+)
+
+// defaultTheme is the theme used when none is configured, it also documents the theme format.
+const defaultTheme = `# open tasks without updates for stale_after are stale, open tasks due within due_soon are due soon
+stale_after: 30d
+due_soon: 7d
+
+# each rule applies its style to the tasks matching all its conditions:
+#   - match: a task filter, as with --filter
+#   - stale: true or false
+#   - due: none, done, overdue, soon or later
+# the colors and shapes are Graphviz ones, later rules override the colors of the earlier ones and "style"
+# values add up, e.g. filled and dashed. A "label" color is the color of the first colored label of the task.
+nodes:
+  - match: kind:pr
+    style: {style: dashed}
+  - style: {style: filled, fillcolor: label}
+  - match: state:closed
+    style: {color: gray, fontcolor: gray}
+  - stale: true
+    style: {color: goldenrod, penwidth: "2"}
+  - due: soon
+    style: {fontcolor: darkorange}
+  - due: overdue
+    style: {fontcolor: red, penwidth: "3"}
+
+# edge rules match the dependency (from) and the task depending on it (to)
+edges:
+  - from: state:closed
+    style: {style: dashed, color: gray}
+`
+
+// Theme maps tasks to the style of their node and dependencies to the style of their edge, see defaultTheme.
+//
+// It is applied by the graph formats of Run, the cytoscape one exports the styles in the data of its elements.
+// The web UI does not use it: it renders the tasks of the Graph API with its own stylesheet.
+type Theme struct {
+	StaleAfter string     `yaml:"stale_after"`
+	DueSoon    string     `yaml:"due_soon"`
+	Nodes      []NodeRule `yaml:"nodes"`
+	Edges      []EdgeRule `yaml:"edges"`
+
+	staleAfter time.Duration
+	dueSoon    time.Duration
+}
+
+// NodeRule applies a style to the tasks matching all its conditions.
+type NodeRule struct {
+	Match string `yaml:"match"`
+	Stale *bool  `yaml:"stale"`
+	Due   string `yaml:"due"`
+	Style Style  `yaml:"style"`
+
+	filter *dvstore.TaskFilter
+}
+
+// EdgeRule applies a style to the dependencies between tasks matching From (the dependency) and To.
+type EdgeRule struct {
+	From  string `yaml:"from"`
+	To    string `yaml:"to"`
+	Style Style  `yaml:"style"`
+
+	from *dvstore.TaskFilter
+	to   *dvstore.TaskFilter
+}
+
+// Style is a set of Graphviz attributes, renderers without an equivalent ignore them.
+type Style struct {
+	Shape     string `yaml:"shape,omitempty" json:"shape,omitempty"`
+	Color     string `yaml:"color,omitempty" json:"color,omitempty"`
+	FillColor string `yaml:"fillcolor,omitempty" json:"fillcolor,omitempty"`
+	FontColor string `yaml:"fontcolor,omitempty" json:"fontcolor,omitempty"`
+	Style     string `yaml:"style,omitempty" json:"style,omitempty"`
+	PenWidth  string `yaml:"penwidth,omitempty" json:"penwidth,omitempty"`
+}
+
+// DefaultTheme returns the built-in theme.
+func DefaultTheme() *Theme {
+	theme, err := ParseTheme([]byte(defaultTheme))
+	if err != nil {
+		panic(err)
+	}
+	return theme
+}
+
+// LoadTheme reads a YAML theme file, or returns the built-in theme if path is empty.
+func LoadTheme(path string) (*Theme, error) {
+	if path == "" {
+		return DefaultTheme(), nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	theme, err := ParseTheme(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return theme, nil
+}
+
+// ParseTheme parses a YAML theme and its rules' filters.
+func ParseTheme(input []byte) (*Theme, error) {
+	var theme Theme
+	if err := yaml.UnmarshalStrict(input, &theme); err != nil {
+		return nil, fmt.Errorf("parse theme: %w", err)
+	}
+	for _, duration := range []struct {
+		input  string
+		output *time.Duration
+	}{
+		{theme.StaleAfter, &theme.staleAfter},
+		{theme.DueSoon, &theme.dueSoon},
+	} {
+		if duration.input == "" {
+			continue
+		}
+		parsed, err := str2duration.ParseDuration(duration.input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration: %q", duration.input)
+		}
+		*duration.output = parsed
+	}
+
+	var err error
+	for i := range theme.Nodes {
+		rule := &theme.Nodes[i]
+		if rule.filter, err = dvstore.ParseTaskFilter(rule.Match); err != nil {
+			return nil, fmt.Errorf("node rule %d: %w", i+1, err)
+		}
+		switch rule.Due {
+		case "", "none", "done", "overdue", "soon", "later":
+		default:
+			return nil, fmt.Errorf("node rule %d: invalid due: %q (none, done, overdue, soon, later)", i+1, rule.Due)
+		}
+	}
+	for i := range theme.Edges {
+		rule := &theme.Edges[i]
+		if rule.from, err = dvstore.ParseTaskFilter(rule.From); err != nil {
+			return nil, fmt.Errorf("edge rule %d: %w", i+1, err)
+		}
+		if rule.to, err = dvstore.ParseTaskFilter(rule.To); err != nil {
+			return nil, fmt.Errorf("edge rule %d: %w", i+1, err)
+		}
+	}
+	return &theme, nil
+}
+
+// nodeStyle returns the style of a task, its labels are looked up in topics to resolve the "label" colors.
+func (t *Theme) nodeStyle(task dvmodel.Task, topics map[quad.IRI]dvmodel.Topic, now time.Time) Style {
+	labelColor := ""
+	for _, label := range task.HasLabel {
+		if color := topics[label].Color; color != "" {
+			labelColor = color
+			break
+		}
+	}
+
+	style := Style{}
+	for _, rule := range t.Nodes {
+		if !rule.filter.Match(task) {
+			continue
+		}
+		if rule.Stale != nil && *rule.Stale != t.isStale(task, now) {
+			continue
+		}
+		if rule.Due != "" && rule.Due != t.dueStatus(task, now) {
+			continue
+		}
+		ruleStyle, ok := rule.Style.withLabelColor(labelColor)
+		if !ok {
+			continue
+		}
+		style = style.merge(ruleStyle)
+	}
+	return style
+}
+
+// edgeStyle returns the style of the dependency of to on from, which are nil if they are not tasks (e.g., Start).
+func (t *Theme) edgeStyle(from, to *dvmodel.Task) Style {
+	style := Style{}
+	for _, rule := range t.Edges {
+		if !matchOptionalTask(rule.from, from) || !matchOptionalTask(rule.to, to) {
+			continue
+		}
+		style = style.merge(rule.Style)
+	}
+	return style
+}
+
+func matchOptionalTask(filter *dvstore.TaskFilter, task *dvmodel.Task) bool {
+	if task == nil {
+		return filter == nil
+	}
+	return filter.Match(*task)
+}
+
+// isStale returns true if task is open and was not updated for the theme's stale_after duration.
+func (t *Theme) isStale(task dvmodel.Task, now time.Time) bool {
+	return t.staleAfter > 0 && task.State != dvmodel.Task_Closed && task.UpdatedAt != nil && now.Sub(*task.UpdatedAt) > t.staleAfter
+}
+
+// dueStatus returns none without a due date, done for closed tasks, and overdue, soon or later for the open ones.
+func (t *Theme) dueStatus(task dvmodel.Task, now time.Time) string {
+	switch {
+	case task.DueOn == nil:
+		return "none"
+	case task.State == dvmodel.Task_Closed:
+		return "done"
+	case task.DueOn.Before(now):
+		return "overdue"
+	case task.DueOn.Before(now.Add(t.dueSoon)):
+		return "soon"
+	default:
+		return "later"
+	}
+}
+
+// withLabelColor replaces the "label" colors of s with color, ok is false if there is no color to use.
+func (s Style) withLabelColor(color string) (Style, bool) {
+	for _, field := range []*string{&s.Color, &s.FillColor, &s.FontColor} {
+		if *field == "label" {
+			if color == "" {
+				return s, false
+			}
+			*field = color
+		}
+	}
+	return s, true
+}
+
+// merge returns s overridden by the non-empty attributes of other, the style values add up.
+func (s Style) merge(other Style) Style {
+	for _, field := range []struct{ dst, src *string }{
+		{&s.Shape, &other.Shape},
+		{&s.Color, &other.Color},
+		{&s.FillColor, &other.FillColor},
+		{&s.FontColor, &other.FontColor},
+		{&s.PenWidth, &other.PenWidth},
+	} {
+		if *field.src != "" {
+			*field.dst = *field.src
+		}
+	}
+	s.Style = joinStyles(s.Style, other.Style)
+	return s
+}
+
+// attrs returns the non-empty Graphviz attributes of s.
+func (s Style) attrs() map[string]string {
+	attrs := map[string]string{}
+	for name, value := range map[string]string{
+		"shape":     s.Shape,
+		"color":     s.Color,
+		"fillcolor": s.FillColor,
+		"fontcolor": s.FontColor,
+		"style":     s.Style,
+		"penwidth":  s.PenWidth,
+	} {
+		if value != "" {
+			attrs[name] = value
+		}
+	}
+	return attrs
+}
+
+// joinStyles returns the union of comma-separated Graphviz styles, e.g. "rounded" and "filled,dashed".
+func joinStyles(styles ...string) string {
+	seen := map[string]bool{}
+	joined := []string{}
+	for _, style := range styles {
+		for _, value := range strings.Split(style, ",") {
+			value = strings.TrimSpace(value)
+			if value != "" && !seen[value] {
+				seen[value] = true
+				joined = append(joined, value)
+			}
+		}
+	}
+	return strings.Join(joined, ",")
+}
+
+// renderTime is the time staleness and due dates are compared with, asOf if the graph is rendered from the snapshots.
+func renderTime(asOf time.Time) time.Time {
+	if !asOf.IsZero() {
+		return asOf
+	}
+	return time.Now()
+}
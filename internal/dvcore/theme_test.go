@@ -0,0 +1,97 @@
+package dvcore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/graphman"
+	"moul.io/graphman/viz"
+)
+
+func TestTheme(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	date := func(days int) *time.Time {
+		t := now.AddDate(0, 0, days)
+		return &t
+	}
+	topics := map[quad.IRI]dvmodel.Topic{
+		"https://github.com/a/b/labels/bug": {ID: "https://github.com/a/b/labels/bug", Color: "#d73a4a"},
+		"https://github.com/a/b/labels/wip": {ID: "https://github.com/a/b/labels/wip"},
+	}
+	theme := DefaultTheme()
+
+	cases := []struct {
+		name     string
+		task     dvmodel.Task
+		expected Style
+	}{
+		{"plain", dvmodel.Task{Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, UpdatedAt: date(-1)}, Style{}},
+		{"pr", dvmodel.Task{Kind: dvmodel.Task_MergeRequest, State: dvmodel.Task_Open}, Style{Style: "dashed"}},
+		{"label", dvmodel.Task{Kind: dvmodel.Task_MergeRequest, HasLabel: []quad.IRI{"https://github.com/a/b/labels/wip", "https://github.com/a/b/labels/bug"}}, Style{Style: "dashed,filled", FillColor: "#d73a4a"}},
+		{"uncolored label", dvmodel.Task{Kind: dvmodel.Task_Issue, HasLabel: []quad.IRI{"https://github.com/a/b/labels/wip"}}, Style{}},
+		{"closed", dvmodel.Task{Kind: dvmodel.Task_Issue, State: dvmodel.Task_Closed, UpdatedAt: date(-100), DueOn: date(-10)}, Style{Color: "gray", FontColor: "gray"}},
+		{"stale", dvmodel.Task{Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, UpdatedAt: date(-31)}, Style{Color: "goldenrod", PenWidth: "2"}},
+		{"due soon", dvmodel.Task{Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, DueOn: date(3)}, Style{FontColor: "darkorange"}},
+		{"due later", dvmodel.Task{Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, DueOn: date(30)}, Style{}},
+		{"overdue and stale", dvmodel.Task{Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, UpdatedAt: date(-40), DueOn: date(-1)}, Style{Color: "goldenrod", FontColor: "red", PenWidth: "3"}},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, theme.nodeStyle(tc.task, topics, now), tc.name)
+	}
+
+	closed := dvmodel.Task{State: dvmodel.Task_Closed}
+	open := dvmodel.Task{State: dvmodel.Task_Open}
+	assert.Equal(t, Style{Style: "dashed", Color: "gray"}, theme.edgeStyle(&closed, &open))
+	assert.Equal(t, Style{}, theme.edgeStyle(&open, &closed))
+	assert.Equal(t, Style{}, theme.edgeStyle(nil, &open))
+
+	custom, err := ParseTheme([]byte(`
+nodes:
+  - match: assignee:alice
+    style: {shape: ellipse, color: blue}
+edges:
+  - to: label:bug
+    style: {penwidth: "2"}
+`))
+	require.NoError(t, err)
+	alice := dvmodel.Task{HasAssignee: []quad.IRI{"https://github.com/alice"}, HasLabel: []quad.IRI{"https://github.com/a/b/labels/bug"}}
+	assert.Equal(t, Style{Shape: "ellipse", Color: "blue"}, custom.nodeStyle(alice, topics, now))
+	assert.Equal(t, Style{PenWidth: "2"}, custom.edgeStyle(nil, &alice))
+
+	for _, invalid := range []string{
+		"nodes: [{match: 'foo:bar'}]",
+		"nodes: [{due: yesterday}]",
+		"stale_after: never",
+		"nodes: [{colour: red}]",
+	} {
+		_, err := ParseTheme([]byte(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestApplyTheme(t *testing.T) {
+	tasks := dvmodel.Tasks{
+		{ID: "https://github.com/a/b/issues/1", Kind: dvmodel.Task_Issue, State: dvmodel.Task_Closed},
+		{ID: "https://github.com/a/b/pull/2", Kind: dvmodel.Task_MergeRequest, State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{"https://github.com/a/b/issues/1"}},
+	}
+	graph := graphman.FromPertConfig(*graphmanPertConfig(tasks, RunOpts{Logger: zap.NewNop()}))
+	graph.GetVertex("https://github.com/a/b/issues/1").SetColor("red")
+	s, err := viz.ToGraphviz(graph, nil)
+	require.NoError(t, err)
+	dot, err := parseDot(s)
+	require.NoError(t, err)
+	require.NoError(t, dot.applyTheme(DefaultTheme(), tasks, nil, time.Now()))
+
+	issue := dot.Nodes.Lookup[`"https://github.com/a/b/issues/1"`].Attrs
+	assert.Equal(t, "red", issue["color"])
+	assert.Equal(t, `"gray"`, issue["fontcolor"])
+	pr := dot.Nodes.Lookup[`"https://github.com/a/b/pull/2"`].Attrs
+	assert.Equal(t, `"rounded,dashed"`, pr["style"])
+	edge := dot.Edges.SrcToDsts[`"https://github.com/a/b/issues/1"`][`"https://github.com/a/b/pull/2"`][0].Attrs
+	assert.Equal(t, `"gray"`, edge["color"])
+}
@@ -0,0 +1,137 @@
+package dvmodel
+
+import (
+	"sort"
+
+	"github.com/cayleygraph/quad"
+)
+
+// Cycles returns the dependency cycles between the tasks, i.e. their strongly connected components.
+//
+// A dependency is either an isDependingOn relationship or the opposite of an isBlocking one, and only the
+// dependencies between tasks of t are considered.
+func (t Tasks) Cycles() []*Cycle {
+	// dependency graph
+	known := map[quad.IRI]bool{}
+	for _, task := range t {
+		known[task.ID] = true
+	}
+	deps := map[quad.IRI][]quad.IRI{}
+	seen := map[Cycle_Dependency]bool{}
+	addDep := func(task, dependsOn quad.IRI) {
+		dep := Cycle_Dependency{Task: task, DependsOn: dependsOn}
+		if !known[task] || !known[dependsOn] || seen[dep] {
+			return
+		}
+		seen[dep] = true
+		deps[task] = append(deps[task], dependsOn)
+	}
+	ids := make([]quad.IRI, 0, len(t))
+	for _, task := range t {
+		ids = append(ids, task.ID)
+		for _, dep := range task.IsDependingOn {
+			addDep(task.ID, dep)
+		}
+		for _, blocked := range task.IsBlocking {
+			addDep(blocked, task.ID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, targets := range deps {
+		sort.Slice(targets, func(i, j int) bool { return targets[i] < targets[j] })
+	}
+
+	// Tarjan's strongly connected components
+	var (
+		index   = map[quad.IRI]int{}
+		lowLink = map[quad.IRI]int{}
+		onStack = map[quad.IRI]bool{}
+		stack   = []quad.IRI{}
+		cycles  = []*Cycle{}
+	)
+	var connect func(id quad.IRI)
+	connect = func(id quad.IRI) {
+		index[id] = len(index)
+		lowLink[id] = index[id]
+		stack = append(stack, id)
+		onStack[id] = true
+		for _, dep := range deps[id] {
+			if _, visited := index[dep]; !visited {
+				connect(dep)
+				if lowLink[dep] < lowLink[id] {
+					lowLink[id] = lowLink[dep]
+				}
+			} else if onStack[dep] && index[dep] < lowLink[id] {
+				lowLink[id] = index[dep]
+			}
+		}
+		if lowLink[id] != index[id] {
+			return
+		}
+		component := map[quad.IRI]bool{}
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component[last] = true
+			if last == id {
+				break
+			}
+		}
+		if cycle := newCycle(component, deps); cycle != nil {
+			cycles = append(cycles, cycle)
+		}
+	}
+	for _, id := range ids {
+		if _, visited := index[id]; !visited {
+			connect(id)
+		}
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i].Tasks[0] < cycles[j].Tasks[0] })
+	return cycles
+}
+
+// newCycle returns the Cycle of a strongly connected component, or nil if the component is a single task not
+// depending on itself.
+func newCycle(component map[quad.IRI]bool, deps map[quad.IRI][]quad.IRI) *Cycle {
+	cycle := Cycle{}
+	for id := range component {
+		cycle.Tasks = append(cycle.Tasks, id)
+	}
+	sort.Slice(cycle.Tasks, func(i, j int) bool { return cycle.Tasks[i] < cycle.Tasks[j] })
+	for _, id := range cycle.Tasks {
+		for _, dep := range deps[id] {
+			if component[dep] {
+				cycle.Dependencies = append(cycle.Dependencies, &Cycle_Dependency{Task: id, DependsOn: dep})
+			}
+		}
+	}
+	if len(cycle.Dependencies) == 0 {
+		return nil
+	}
+
+	// the shortest closed path through the first task, found breadth-first
+	start := cycle.Tasks[0]
+	previous := map[quad.IRI]quad.IRI{}
+	queue := []quad.IRI{start}
+	for len(queue) > 0 && previous[start] == "" {
+		id := queue[0]
+		queue = queue[1:]
+		for _, dep := range deps[id] {
+			if !component[dep] || previous[dep] != "" {
+				continue
+			}
+			previous[dep] = id
+			if dep == start {
+				break
+			}
+			queue = append(queue, dep)
+		}
+	}
+	cycle.Path = []quad.IRI{previous[start]}
+	for id := previous[start]; id != start; {
+		id = previous[id]
+		cycle.Path = append([]quad.IRI{id}, cycle.Path...)
+	}
+	return &cycle
+}
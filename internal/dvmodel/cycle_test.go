@@ -0,0 +1,44 @@
+package dvmodel
+
+import (
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTasksCycles(t *testing.T) {
+	tasks := Tasks{
+		// 1 -> 2 -> 3 -> 1, and 3 -> 2
+		{ID: "https://example.com/1", IsDependingOn: []quad.IRI{"https://example.com/2"}},
+		{ID: "https://example.com/2", IsDependingOn: []quad.IRI{"https://example.com/3"}},
+		{ID: "https://example.com/3", IsDependingOn: []quad.IRI{"https://example.com/1", "https://example.com/2", "https://example.com/4"}},
+		// 4 is not part of a cycle
+		{ID: "https://example.com/4", IsDependingOn: []quad.IRI{"https://example.com/unknown"}},
+		// 5 -> 6 -> 5, declared with isBlocking
+		{ID: "https://example.com/5", IsBlocking: []quad.IRI{"https://example.com/6"}},
+		{ID: "https://example.com/6", IsBlocking: []quad.IRI{"https://example.com/5"}, IsRelatedWith: []quad.IRI{"https://example.com/4"}},
+		// 7 depends on itself
+		{ID: "https://example.com/7", IsDependingOn: []quad.IRI{"https://example.com/7"}},
+	}
+	cycles := tasks.Cycles()
+	require.Len(t, cycles, 3)
+
+	assert.Equal(t, []quad.IRI{"https://example.com/1", "https://example.com/2", "https://example.com/3"}, cycles[0].Path)
+	assert.Equal(t, []quad.IRI{"https://example.com/1", "https://example.com/2", "https://example.com/3"}, cycles[0].Tasks)
+	assert.Equal(t, []*Cycle_Dependency{
+		{Task: "https://example.com/1", DependsOn: "https://example.com/2"},
+		{Task: "https://example.com/2", DependsOn: "https://example.com/3"},
+		{Task: "https://example.com/3", DependsOn: "https://example.com/1"},
+		{Task: "https://example.com/3", DependsOn: "https://example.com/2"},
+	}, cycles[0].Dependencies)
+
+	assert.Equal(t, []quad.IRI{"https://example.com/5", "https://example.com/6"}, cycles[1].Path)
+	assert.Len(t, cycles[1].Dependencies, 2)
+
+	assert.Equal(t, []quad.IRI{"https://example.com/7"}, cycles[2].Path)
+	assert.Equal(t, []*Cycle_Dependency{{Task: "https://example.com/7", DependsOn: "https://example.com/7"}}, cycles[2].Dependencies)
+
+	assert.Empty(t, tasks[3:4].Cycles())
+}
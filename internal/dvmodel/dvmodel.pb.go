@@ -231,6 +231,7 @@ type Owner struct {
 	Description string                          `protobuf:"bytes,15,opt,name=description,proto3" json:"description,omitempty" quad:"schema:description,optional"`
 	ForkStatus  Owner_ForkStatus                `protobuf:"varint,16,opt,name=fork_status,json=forkStatus,proto3,enum=depviz.model.Owner_ForkStatus" json:"fork_status,omitempty" quad:"schema:forkStatus,optional"`
 	AvatarURL   string                          `protobuf:"bytes,17,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty" quad:"schema:avatarUrl,optional"`
+	Email       string                          `protobuf:"bytes,18,opt,name=email,proto3" json:"email,omitempty" quad:"schema:email,optional"`
 	// relationships
 	HasOwner github_com_cayleygraph_quad.IRI `protobuf:"bytes,100,opt,name=has_owner,json=hasOwner,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"has_owner,omitempty" quad:"hasOwner,optional"`
 }
@@ -301,7 +302,7 @@ type Task struct {
 	IsBlocking    []github_com_cayleygraph_quad.IRI `protobuf:"bytes,107,rep,name=is_blocking,json=isBlocking,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"is_blocking,omitempty" quad:"isBlocking,optional"`
 	IsRelatedWith []github_com_cayleygraph_quad.IRI `protobuf:"bytes,108,rep,name=is_related_with,json=isRelatedWith,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"is_related_with,omitempty" quad:"isRelatedWith,optional"`
 	IsPartOf      []github_com_cayleygraph_quad.IRI `protobuf:"bytes,109,rep,name=is_part_of,json=isPartOf,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"is_part_of,omitempty" quad:"isPartOf,optional"`
-	HasPart       []github_com_cayleygraph_quad.IRI `protobuf:"bytes,110,rep,name=has_part,json=hasPart,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"has_part,omitempty" quad:"isPartOf,optional"`
+	HasPart       []github_com_cayleygraph_quad.IRI `protobuf:"bytes,110,rep,name=has_part,json=hasPart,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"has_part,omitempty" quad:"hasPart,optional"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -423,6 +424,214 @@ func (m *Batch) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Batch proto.InternalMessageInfo
 
+type StoreInfo struct {
+	Backend            string                 `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	Location           string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	SizeBytes          int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Quads              int64                  `protobuf:"varint,4,opt,name=quads,proto3" json:"quads,omitempty"`
+	Owners             int64                  `protobuf:"varint,5,opt,name=owners,proto3" json:"owners,omitempty"`
+	Tasks              int64                  `protobuf:"varint,6,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Topics             int64                  `protobuf:"varint,7,opt,name=topics,proto3" json:"topics,omitempty"`
+	Relationships      map[string]int64       `protobuf:"bytes,8,rep,name=relationships,proto3" json:"relationships,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Repos              []*StoreInfo_Repo      `protobuf:"bytes,9,rep,name=repos,proto3" json:"repos,omitempty"`
+	DanglingReferences []*StoreInfo_Reference `protobuf:"bytes,10,rep,name=dangling_references,json=danglingReferences,proto3" json:"dangling_references,omitempty"`
+	LastUpdatedAt      *time.Time             `protobuf:"bytes,11,opt,name=last_updated_at,json=lastUpdatedAt,proto3,stdtime" json:"last_updated_at,omitempty"`
+	LastSyncedAt       *time.Time             `protobuf:"bytes,12,opt,name=last_synced_at,json=lastSyncedAt,proto3,stdtime" json:"last_synced_at,omitempty"`
+	SchemaVersion      int32                  `protobuf:"varint,13,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+}
+
+func (m *StoreInfo) Reset()         { *m = StoreInfo{} }
+func (m *StoreInfo) String() string { return proto.CompactTextString(m) }
+func (*StoreInfo) ProtoMessage()    {}
+func (*StoreInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106647ce772da30c, []int{4}
+}
+func (m *StoreInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo.Merge(m, src)
+}
+func (m *StoreInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreInfo proto.InternalMessageInfo
+
+type StoreInfo_Repo struct {
+	ID            github_com_cayleygraph_quad.IRI `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"id,omitempty"`
+	Tasks         int64                           `protobuf:"varint,2,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	TasksByKind   map[string]int64                `protobuf:"bytes,3,rep,name=tasks_by_kind,json=tasksByKind,proto3" json:"tasks_by_kind,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TasksByState  map[string]int64                `protobuf:"bytes,4,rep,name=tasks_by_state,json=tasksByState,proto3" json:"tasks_by_state,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LastUpdatedAt *time.Time                      `protobuf:"bytes,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3,stdtime" json:"last_updated_at,omitempty"`
+	LastSyncedAt  *time.Time                      `protobuf:"bytes,6,opt,name=last_synced_at,json=lastSyncedAt,proto3,stdtime" json:"last_synced_at,omitempty"`
+}
+
+func (m *StoreInfo_Repo) Reset()         { *m = StoreInfo_Repo{} }
+func (m *StoreInfo_Repo) String() string { return proto.CompactTextString(m) }
+func (*StoreInfo_Repo) ProtoMessage()    {}
+func (*StoreInfo_Repo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106647ce772da30c, []int{4, 0}
+}
+func (m *StoreInfo_Repo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreInfo_Repo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreInfo_Repo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreInfo_Repo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo_Repo.Merge(m, src)
+}
+func (m *StoreInfo_Repo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreInfo_Repo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo_Repo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreInfo_Repo proto.InternalMessageInfo
+
+type StoreInfo_Reference struct {
+	Subject   github_com_cayleygraph_quad.IRI `protobuf:"bytes,1,opt,name=subject,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"subject,omitempty"`
+	Predicate github_com_cayleygraph_quad.IRI `protobuf:"bytes,2,opt,name=predicate,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"predicate,omitempty"`
+	Object    github_com_cayleygraph_quad.IRI `protobuf:"bytes,3,opt,name=object,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"object,omitempty"`
+}
+
+func (m *StoreInfo_Reference) Reset()         { *m = StoreInfo_Reference{} }
+func (m *StoreInfo_Reference) String() string { return proto.CompactTextString(m) }
+func (*StoreInfo_Reference) ProtoMessage()    {}
+func (*StoreInfo_Reference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106647ce772da30c, []int{4, 1}
+}
+func (m *StoreInfo_Reference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreInfo_Reference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreInfo_Reference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreInfo_Reference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo_Reference.Merge(m, src)
+}
+func (m *StoreInfo_Reference) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreInfo_Reference) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo_Reference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreInfo_Reference proto.InternalMessageInfo
+
+// Cycle is a group of tasks depending on each other, see Tasks.Cycles.
+type Cycle struct {
+	Path         []github_com_cayleygraph_quad.IRI `protobuf:"bytes,1,rep,name=path,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"path,omitempty"`
+	Tasks        []github_com_cayleygraph_quad.IRI `protobuf:"bytes,2,rep,name=tasks,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"tasks,omitempty"`
+	Dependencies []*Cycle_Dependency               `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (m *Cycle) Reset()         { *m = Cycle{} }
+func (m *Cycle) String() string { return proto.CompactTextString(m) }
+func (*Cycle) ProtoMessage()    {}
+func (*Cycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106647ce772da30c, []int{5}
+}
+func (m *Cycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Cycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Cycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cycle.Merge(m, src)
+}
+func (m *Cycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cycle proto.InternalMessageInfo
+
+type Cycle_Dependency struct {
+	Task      github_com_cayleygraph_quad.IRI `protobuf:"bytes,1,opt,name=task,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"task,omitempty"`
+	DependsOn github_com_cayleygraph_quad.IRI `protobuf:"bytes,2,opt,name=depends_on,json=dependsOn,proto3,casttype=github.com/cayleygraph/quad.IRI" json:"depends_on,omitempty"`
+}
+
+func (m *Cycle_Dependency) Reset()         { *m = Cycle_Dependency{} }
+func (m *Cycle_Dependency) String() string { return proto.CompactTextString(m) }
+func (*Cycle_Dependency) ProtoMessage()    {}
+func (*Cycle_Dependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106647ce772da30c, []int{5, 0}
+}
+func (m *Cycle_Dependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cycle_Dependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Cycle_Dependency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Cycle_Dependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cycle_Dependency.Merge(m, src)
+}
+func (m *Cycle_Dependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cycle_Dependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cycle_Dependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cycle_Dependency proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("depviz.model.Driver", Driver_name, Driver_value)
 	golang_proto.RegisterEnum("depviz.model.Driver", Driver_name, Driver_value)
@@ -444,107 +653,156 @@ func init() {
 	golang_proto.RegisterType((*Topic)(nil), "depviz.model.Topic")
 	proto.RegisterType((*Batch)(nil), "depviz.model.Batch")
 	golang_proto.RegisterType((*Batch)(nil), "depviz.model.Batch")
+	proto.RegisterType((*StoreInfo)(nil), "depviz.model.StoreInfo")
+	golang_proto.RegisterType((*StoreInfo)(nil), "depviz.model.StoreInfo")
+	proto.RegisterMapType((map[string]int64)(nil), "depviz.model.StoreInfo.RelationshipsEntry")
+	golang_proto.RegisterMapType((map[string]int64)(nil), "depviz.model.StoreInfo.RelationshipsEntry")
+	proto.RegisterType((*StoreInfo_Repo)(nil), "depviz.model.StoreInfo.Repo")
+	golang_proto.RegisterType((*StoreInfo_Repo)(nil), "depviz.model.StoreInfo.Repo")
+	proto.RegisterMapType((map[string]int64)(nil), "depviz.model.StoreInfo.Repo.TasksByKindEntry")
+	golang_proto.RegisterMapType((map[string]int64)(nil), "depviz.model.StoreInfo.Repo.TasksByKindEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "depviz.model.StoreInfo.Repo.TasksByStateEntry")
+	golang_proto.RegisterMapType((map[string]int64)(nil), "depviz.model.StoreInfo.Repo.TasksByStateEntry")
+	proto.RegisterType((*StoreInfo_Reference)(nil), "depviz.model.StoreInfo.Reference")
+	golang_proto.RegisterType((*StoreInfo_Reference)(nil), "depviz.model.StoreInfo.Reference")
+	proto.RegisterType((*Cycle)(nil), "depviz.model.Cycle")
+	golang_proto.RegisterType((*Cycle)(nil), "depviz.model.Cycle")
+	proto.RegisterType((*Cycle_Dependency)(nil), "depviz.model.Cycle.Dependency")
+	golang_proto.RegisterType((*Cycle_Dependency)(nil), "depviz.model.Cycle.Dependency")
 }
 
 func init() { proto.RegisterFile("dvmodel.proto", fileDescriptor_106647ce772da30c) }
 func init() { golang_proto.RegisterFile("dvmodel.proto", fileDescriptor_106647ce772da30c) }
 
 var fileDescriptor_106647ce772da30c = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x41, 0x6f, 0xdb, 0x46,
-	0x16, 0x16, 0x65, 0x4b, 0xb1, 0x9e, 0xe4, 0x98, 0x9e, 0x24, 0x58, 0xae, 0x77, 0x57, 0x54, 0x94,
-	0xdd, 0x8d, 0xda, 0x26, 0x12, 0x9a, 0x02, 0x29, 0x10, 0xa0, 0x48, 0x2c, 0xbb, 0x49, 0x84, 0x3a,
-	0xb5, 0xa1, 0xd8, 0x28, 0x90, 0xa6, 0x10, 0x46, 0xe2, 0x58, 0x9a, 0x8a, 0xe4, 0x30, 0x1c, 0xd2,
-	0x46, 0xf2, 0x17, 0x7a, 0xc9, 0xa1, 0x3f, 0xa2, 0x3f, 0xa3, 0x47, 0x1f, 0x7a, 0xc8, 0xb1, 0x27,
-	0xb6, 0xb1, 0xff, 0x81, 0x4e, 0x45, 0x4f, 0xc5, 0x0c, 0x49, 0x89, 0xb4, 0x94, 0x34, 0x0a, 0xdc,
-	0xf4, 0x92, 0x9b, 0xf8, 0xde, 0xf7, 0xbe, 0xef, 0x0d, 0xdf, 0xcc, 0x9b, 0x47, 0xc1, 0xb2, 0x71,
-	0x60, 0x31, 0x83, 0x98, 0x75, 0xc7, 0x65, 0x1e, 0x43, 0x25, 0x83, 0x38, 0x07, 0xf4, 0x59, 0x5d,
-	0xda, 0xd6, 0xf4, 0x3e, 0x63, 0x7d, 0x93, 0x34, 0xa4, 0xaf, 0xeb, 0xef, 0x37, 0x3c, 0x6a, 0x11,
-	0xee, 0x61, 0xcb, 0x09, 0xe1, 0x6b, 0xd7, 0xfb, 0xd4, 0x1b, 0xf8, 0xdd, 0x7a, 0x8f, 0x59, 0x8d,
-	0x3e, 0xeb, 0xb3, 0x09, 0x52, 0x3c, 0xc9, 0x07, 0xf9, 0x2b, 0x84, 0x57, 0x7f, 0x2a, 0x40, 0x6e,
-	0xfb, 0xd0, 0x26, 0x2e, 0xba, 0x07, 0x59, 0x6a, 0x68, 0x4a, 0x45, 0xa9, 0x15, 0x9a, 0x9f, 0x1e,
-	0x07, 0x7a, 0xb6, 0xb5, 0x39, 0x0a, 0x74, 0x78, 0xe2, 0x63, 0xe3, 0x56, 0xf5, 0x0e, 0x35, 0xaa,
-	0xbf, 0x07, 0xba, 0x9e, 0x20, 0xef, 0xe1, 0xa7, 0x26, 0x79, 0xda, 0x77, 0xb1, 0x33, 0x68, 0x08,
-	0x50, 0xbd, 0xd5, 0x6e, 0xb5, 0xb3, 0xd4, 0x40, 0x7d, 0x80, 0x9e, 0x4b, 0xb0, 0x47, 0x8c, 0x0e,
-	0xf6, 0xb4, 0x85, 0x8a, 0x52, 0x2b, 0xde, 0x58, 0xab, 0x87, 0x79, 0xd7, 0xe3, 0x6c, 0xea, 0xbb,
-	0x71, 0xde, 0xcd, 0x6b, 0x47, 0x81, 0xae, 0x8c, 0x02, 0xbd, 0x12, 0x4a, 0xf1, 0xde, 0x80, 0x58,
-	0xf8, 0x56, 0x44, 0xb1, 0xee, 0x5d, 0x63, 0x8e, 0x47, 0x99, 0x8d, 0xcd, 0xea, 0xf3, 0x5f, 0x74,
-	0xa5, 0x5d, 0x18, 0x3b, 0x84, 0x90, 0xef, 0x18, 0xb1, 0xd0, 0xe2, 0x5b, 0x0a, 0x45, 0x14, 0xd3,
-	0x42, 0x63, 0x07, 0xba, 0x0f, 0x4b, 0x26, 0xeb, 0x61, 0xb3, 0x43, 0x0d, 0x2d, 0x27, 0x5f, 0xd0,
-	0xf5, 0xe3, 0x40, 0x3f, 0xb7, 0x25, 0x6c, 0xf2, 0x2d, 0x95, 0x53, 0x8c, 0x12, 0xdb, 0x32, 0x26,
-	0x7c, 0xed, 0x73, 0x91, 0x09, 0x3d, 0x80, 0xc5, 0x21, 0xb5, 0x0d, 0x0d, 0x2a, 0x4a, 0xed, 0xfc,
-	0x0d, 0xad, 0x9e, 0xac, 0x6d, 0x5d, 0xd6, 0xa1, 0xfe, 0x05, 0xb5, 0x8d, 0xa6, 0x3e, 0x0a, 0xf4,
-	0x7f, 0xa5, 0x48, 0x45, 0x58, 0x82, 0x51, 0xd2, 0xa0, 0x0d, 0x00, 0x3e, 0x60, 0xae, 0xd7, 0xb1,
-	0xb1, 0x45, 0xb4, 0xa2, 0x4c, 0xed, 0xbf, 0x53, 0x2b, 0x94, 0x90, 0x2f, 0xb1, 0x45, 0x12, 0xf1,
-	0x85, 0xb1, 0x11, 0xdd, 0x81, 0xc2, 0xbe, 0x6f, 0x9a, 0x21, 0x47, 0x49, 0x72, 0x5c, 0x19, 0x05,
-	0xba, 0x9e, 0xe2, 0x10, 0x88, 0x53, 0x14, 0x4b, 0xb1, 0x0d, 0x6d, 0x43, 0xde, 0x70, 0xe9, 0x01,
-	0x71, 0xb5, 0x65, 0xb9, 0xae, 0x8b, 0xe9, 0x75, 0x6d, 0x4a, 0x5f, 0xf3, 0xf2, 0x28, 0xd0, 0xff,
-	0x93, 0x22, 0x0d, 0x83, 0x12, 0x94, 0x11, 0x0d, 0xba, 0x0d, 0x4b, 0x03, 0x66, 0x11, 0x07, 0xf7,
-	0x89, 0x76, 0xfe, 0x15, 0x19, 0xc5, 0x80, 0x64, 0x46, 0xb1, 0x0d, 0xdd, 0x87, 0xa2, 0x41, 0x78,
-	0xcf, 0xa5, 0xd2, 0xa7, 0xad, 0x48, 0x8e, 0xff, 0x8f, 0x02, 0xbd, 0x9a, 0x4e, 0x60, 0x82, 0x49,
-	0xd0, 0x24, 0x43, 0xd1, 0x3e, 0x14, 0xf7, 0x99, 0x3b, 0xec, 0x70, 0x0f, 0x7b, 0x3e, 0xd7, 0x54,
-	0xb9, 0xc0, 0xf2, 0xac, 0xc2, 0xdd, 0x65, 0xee, 0xf0, 0xa1, 0x44, 0x35, 0xff, 0x37, 0x0a, 0xf4,
-	0xcb, 0xe9, 0xf7, 0x37, 0x76, 0x26, 0x84, 0x60, 0x62, 0x45, 0x3b, 0x00, 0xf8, 0x00, 0x7b, 0xd8,
-	0xed, 0xf8, 0xae, 0xa9, 0xad, 0xca, 0x84, 0x3f, 0x3e, 0x0e, 0xf4, 0xc2, 0xba, 0xb4, 0xee, 0xb5,
-	0xb7, 0xa6, 0xea, 0x1a, 0xe2, 0xf7, 0x5c, 0x33, 0x59, 0xd7, 0xb1, 0x11, 0x3d, 0x86, 0xc2, 0x00,
-	0xf3, 0x0e, 0x13, 0xc9, 0x69, 0x86, 0x24, 0xbc, 0x3d, 0x0a, 0x74, 0x2d, 0xe4, 0x18, 0x60, 0x2e,
-	0xd3, 0x9e, 0xc4, 0xbe, 0xc9, 0xf9, 0x5e, 0x8a, 0xc3, 0xaa, 0x7b, 0xb0, 0x28, 0x76, 0x2a, 0x5a,
-	0x81, 0xe2, 0x9e, 0x3d, 0xb4, 0xd9, 0xa1, 0x2d, 0x1e, 0xd5, 0x0c, 0x5a, 0x82, 0xc5, 0x3d, 0x4e,
-	0x5c, 0x55, 0x41, 0x2a, 0x94, 0xb6, 0xdd, 0x3e, 0xb6, 0xe9, 0x33, 0x2c, 0x24, 0xd4, 0xac, 0xf0,
-	0xed, 0x12, 0x6c, 0xa9, 0x0b, 0xe2, 0x57, 0x9b, 0x38, 0x4c, 0x5d, 0x44, 0x25, 0x58, 0xda, 0x71,
-	0xd9, 0x01, 0x35, 0x88, 0xab, 0xe6, 0xaa, 0x9f, 0x01, 0x4c, 0xde, 0x23, 0xba, 0x04, 0xab, 0x11,
-	0xf9, 0xc4, 0xa8, 0x66, 0x10, 0x40, 0xbe, 0xc5, 0x85, 0x45, 0x55, 0x44, 0x78, 0x8b, 0x3f, 0x64,
-	0xbe, 0xdb, 0x23, 0x6a, 0xb6, 0xfa, 0xfd, 0x45, 0x58, 0xdc, 0xc5, 0x7c, 0xf8, 0xbe, 0x9b, 0xbd,
-	0x8b, 0x6e, 0xb6, 0x95, 0xea, 0x66, 0xff, 0x48, 0x1f, 0x0a, 0x51, 0x86, 0xb9, 0x9a, 0xd9, 0x4d,
-	0xc8, 0x79, 0xd4, 0x33, 0xe3, 0x3e, 0x56, 0x19, 0x05, 0xfa, 0xbf, 0x53, 0x51, 0xd2, 0x9b, 0x08,
-	0x0b, 0xe1, 0xa7, 0xcf, 0x7a, 0xe9, 0xed, 0xcf, 0xfa, 0x99, 0xf7, 0xb1, 0xaf, 0x21, 0x6f, 0xf8,
-	0xa4, 0xc3, 0x6c, 0xd9, 0xc5, 0x5e, 0x5f, 0xcf, 0x5a, 0x54, 0xcf, 0xf4, 0x9a, 0x0d, 0x9f, 0x6c,
-	0xdb, 0xa7, 0x6a, 0x99, 0x93, 0x46, 0x64, 0x41, 0xa9, 0xc7, 0x2c, 0xc7, 0x24, 0xd1, 0x96, 0x59,
-	0xf9, 0x53, 0x89, 0x7a, 0x24, 0x91, 0x7e, 0x31, 0x63, 0x92, 0xa9, 0x4d, 0x53, 0x4c, 0xb8, 0xd0,
-	0x0e, 0xe4, 0x44, 0x0f, 0x24, 0x51, 0x0b, 0xd4, 0x66, 0x54, 0x5b, 0x1c, 0x50, 0x32, 0xa3, 0x70,
-	0x32, 0x2e, 0x59, 0x38, 0x69, 0x40, 0x9b, 0x50, 0xa0, 0xbc, 0x63, 0xb2, 0xde, 0x90, 0x18, 0xb2,
-	0xe3, 0x2d, 0x35, 0xaf, 0x46, 0x19, 0xa6, 0x5b, 0x3d, 0xe5, 0x5b, 0x12, 0x94, 0x6c, 0xf5, 0xb1,
-	0x0d, 0xb5, 0xa0, 0x64, 0xfb, 0x56, 0xa7, 0xc7, 0x2c, 0x8b, 0xd8, 0x1e, 0xd7, 0x50, 0x45, 0xa9,
-	0xe5, 0x66, 0xd4, 0xdf, 0xf6, 0xad, 0x8d, 0x08, 0x93, 0xac, 0x7f, 0xc2, 0x8c, 0xee, 0x82, 0x78,
-	0xec, 0xf8, 0xce, 0x01, 0xf3, 0x08, 0xd7, 0x2e, 0x48, 0xa6, 0xe9, 0x5e, 0x6e, 0xfb, 0xd6, 0x5e,
-	0x08, 0x49, 0xf6, 0xf2, 0x89, 0x15, 0x6d, 0xc1, 0xb2, 0xe0, 0x31, 0xd8, 0xa1, 0x1d, 0x32, 0x5d,
-	0x94, 0x4c, 0x57, 0x47, 0x81, 0x7e, 0xe5, 0x34, 0xd3, 0x66, 0x0c, 0x4a, 0x70, 0x95, 0x92, 0x76,
-	0xf4, 0x18, 0x10, 0xe1, 0x1e, 0xb5, 0x64, 0x6b, 0x30, 0x7c, 0x57, 0x36, 0x53, 0xed, 0x52, 0x78,
-	0x72, 0x47, 0x81, 0xfe, 0x41, 0x8a, 0x72, 0x1a, 0x9a, 0x20, 0x5e, 0x1d, 0x7b, 0x37, 0x23, 0x27,
-	0xea, 0x00, 0x88, 0x5b, 0x02, 0xfb, 0xde, 0x80, 0xc5, 0xd7, 0xc4, 0x9d, 0x51, 0xa0, 0xff, 0x73,
-	0x7c, 0x4d, 0xac, 0x4b, 0xd7, 0x7c, 0xf7, 0x44, 0x61, 0x1c, 0x97, 0xbe, 0x86, 0xc8, 0x19, 0x5f,
-	0x43, 0x68, 0x00, 0xcb, 0x82, 0xdd, 0xa2, 0x26, 0xe1, 0x1e, 0xb3, 0x89, 0xb6, 0x2f, 0x15, 0x36,
-	0x26, 0x7b, 0x70, 0x80, 0xf9, 0x83, 0xd8, 0x3b, 0x9f, 0x4a, 0x29, 0x19, 0x8a, 0x08, 0x94, 0xe4,
-	0x8b, 0xe2, 0x9c, 0xf6, 0x6d, 0x42, 0xb4, 0x7e, 0x65, 0xa1, 0x56, 0x68, 0x36, 0x27, 0xbd, 0x4d,
-	0x2c, 0x39, 0x72, 0xce, 0xa7, 0x53, 0x4c, 0x44, 0xc6, 0x32, 0x2e, 0x39, 0xa0, 0xe4, 0x90, 0xb8,
-	0xda, 0x60, 0x86, 0x4c, 0x3b, 0x72, 0xce, 0x2f, 0x13, 0x47, 0xc6, 0x55, 0x31, 0x71, 0x97, 0x98,
-	0x1a, 0x95, 0x1a, 0xe9, 0xaa, 0x6c, 0x09, 0xcf, 0xfc, 0x55, 0x91, 0x61, 0xc8, 0x84, 0x15, 0xca,
-	0x3b, 0x06, 0x71, 0x88, 0x6d, 0x50, 0xbb, 0x2f, 0x1a, 0xe0, 0xb7, 0x52, 0x63, 0x73, 0xd2, 0x3b,
-	0x29, 0xdf, 0x8c, 0xfd, 0xc9, 0x0e, 0xf7, 0x26, 0x42, 0xcb, 0xa9, 0x58, 0xd4, 0x85, 0x22, 0xe5,
-	0x9d, 0xae, 0x68, 0x24, 0xd4, 0xee, 0x6b, 0x43, 0xa9, 0xb4, 0x3e, 0x0a, 0xf4, 0xb5, 0x58, 0xa9,
-	0x19, 0xf9, 0xe6, 0x93, 0x81, 0x49, 0x60, 0xb4, 0x22, 0x97, 0x98, 0xf2, 0x68, 0x1d, 0x52, 0x6f,
-	0xa0, 0x99, 0xd3, 0x2b, 0x6a, 0x87, 0xfe, 0xaf, 0xa8, 0x37, 0x98, 0x7b, 0x45, 0x89, 0x58, 0xf4,
-	0x0d, 0x00, 0xe5, 0x1d, 0x07, 0xbb, 0x5e, 0x87, 0xed, 0x6b, 0xd6, 0xe9, 0xf2, 0x50, 0xbe, 0x83,
-	0x5d, 0x6f, 0x7b, 0x7f, 0xce, 0xf2, 0xc4, 0x61, 0xe8, 0x11, 0x88, 0x52, 0x49, 0x7e, 0xcd, 0x3e,
-	0x1b, 0xf2, 0x73, 0x03, 0x2c, 0xe3, 0xaa, 0xdd, 0x57, 0xcd, 0x85, 0x05, 0xc8, 0xb5, 0x38, 0xf7,
-	0x49, 0x38, 0x18, 0x3e, 0x20, 0x6e, 0x9f, 0xb4, 0xc9, 0x13, 0x9f, 0x70, 0x4f, 0xcd, 0xa2, 0x65,
-	0x28, 0x8c, 0x4f, 0x5a, 0x38, 0x1d, 0x7e, 0xee, 0xd0, 0x9e, 0xba, 0x28, 0xa2, 0x1e, 0x7a, 0xcc,
-	0x7d, 0xaa, 0xe6, 0x84, 0x71, 0x03, 0xbb, 0x86, 0x9a, 0xaf, 0x36, 0x84, 0x51, 0xdc, 0x20, 0x2a,
-	0x94, 0x22, 0x11, 0xf9, 0x1c, 0x4e, 0x9f, 0xdb, 0x0e, 0xb1, 0x55, 0x45, 0x0c, 0x89, 0x1b, 0x26,
-	0xe3, 0xc4, 0x50, 0xb3, 0xd5, 0xa3, 0x3c, 0xe4, 0x76, 0x99, 0x43, 0x7b, 0xef, 0xe7, 0xc2, 0xbf,
-	0xfd, 0x2b, 0x57, 0xd6, 0xe1, 0x9d, 0x0c, 0x86, 0x93, 0x71, 0xae, 0x74, 0x36, 0xe3, 0xdc, 0x4d,
-	0xc8, 0xf5, 0x98, 0xc9, 0xc2, 0xf1, 0x70, 0x56, 0x22, 0xd2, 0x9b, 0x4c, 0x44, 0x1a, 0x4e, 0x4f,
-	0xa8, 0xe7, 0xdf, 0x7e, 0x42, 0xfd, 0x6b, 0xbf, 0xe9, 0xaa, 0xaf, 0x39, 0xbb, 0xb2, 0xb1, 0xab,
-	0x4a, 0xf5, 0x3b, 0x05, 0x72, 0x4d, 0xec, 0xf5, 0x06, 0xa8, 0x06, 0x39, 0x0f, 0xf3, 0x21, 0xd7,
-	0x94, 0xca, 0x42, 0xad, 0x78, 0x03, 0x4d, 0x0f, 0x84, 0xed, 0x10, 0x80, 0x3e, 0x82, 0xbc, 0xcc,
-	0x98, 0x6b, 0x59, 0x09, 0xbd, 0x30, 0xe3, 0xf3, 0xb9, 0x1d, 0x41, 0x04, 0xd8, 0x13, 0x5b, 0x84,
-	0x6b, 0x0b, 0xb3, 0xc0, 0x72, 0xfb, 0xb4, 0x23, 0xc8, 0x87, 0x57, 0x21, 0x1f, 0x96, 0x11, 0xad,
-	0xc2, 0x72, 0x94, 0x73, 0x68, 0x08, 0x3f, 0x13, 0xef, 0x51, 0xef, 0xbe, 0xdf, 0x55, 0x95, 0x66,
-	0xeb, 0xe8, 0x65, 0x39, 0xf3, 0xdb, 0xcb, 0x72, 0xe6, 0x87, 0xe3, 0x72, 0xe6, 0xe8, 0xb8, 0xac,
-	0xbc, 0x38, 0x2e, 0x2b, 0xbf, 0x1e, 0x97, 0x95, 0xe7, 0x27, 0xe5, 0xcc, 0x8f, 0x27, 0x65, 0xe5,
-	0xc5, 0x49, 0x39, 0xf3, 0xf3, 0x49, 0x39, 0xf3, 0x48, 0xb7, 0x98, 0x6f, 0xd6, 0x29, 0x6b, 0x84,
-	0xb2, 0x0d, 0x6a, 0x7b, 0xc4, 0xb5, 0xb1, 0xd9, 0x88, 0xfe, 0x96, 0xeb, 0xe6, 0xe5, 0xa1, 0xfb,
-	0xe4, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x5c, 0x43, 0x53, 0xa8, 0x13, 0x00, 0x00,
+	// 2023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x41, 0x73, 0xdb, 0xc6,
+	0x15, 0x16, 0x28, 0x82, 0x12, 0x1f, 0x49, 0x19, 0x5e, 0x3b, 0x09, 0xca, 0x26, 0x84, 0x4c, 0x37,
+	0xb5, 0x92, 0xc6, 0xd4, 0xd4, 0x9d, 0x71, 0x1a, 0x77, 0x12, 0x5b, 0x94, 0x92, 0x98, 0xad, 0x5c,
+	0xb9, 0xb0, 0xd5, 0x4e, 0xdb, 0x74, 0x30, 0x20, 0xb0, 0x22, 0x37, 0x04, 0xb0, 0x08, 0x16, 0x90,
+	0x87, 0x3e, 0xe7, 0xd2, 0xe9, 0x25, 0x33, 0x3d, 0xf6, 0x0f, 0xf4, 0x67, 0xf4, 0xe8, 0x69, 0x2f,
+	0x39, 0xf6, 0xc4, 0x36, 0xf2, 0x3f, 0xe0, 0xa9, 0xd3, 0x53, 0x67, 0x77, 0x01, 0x10, 0x14, 0xe5,
+	0x58, 0xf4, 0xb8, 0xc9, 0x25, 0x37, 0xee, 0x7b, 0xef, 0xfb, 0xde, 0xbe, 0x7d, 0xbb, 0xef, 0x3d,
+	0x48, 0xd0, 0x70, 0x8f, 0x7d, 0xea, 0x62, 0xaf, 0x13, 0x46, 0x34, 0xa6, 0xa8, 0xee, 0xe2, 0xf0,
+	0x98, 0x3c, 0xee, 0x08, 0x59, 0xd3, 0x18, 0x50, 0x3a, 0xf0, 0xf0, 0xb6, 0xd0, 0xf5, 0x93, 0xa3,
+	0xed, 0x98, 0xf8, 0x98, 0xc5, 0xb6, 0x1f, 0x4a, 0xf3, 0xe6, 0xf5, 0x01, 0x89, 0x87, 0x49, 0xbf,
+	0xe3, 0x50, 0x7f, 0x7b, 0x40, 0x07, 0x74, 0x66, 0xc9, 0x57, 0x62, 0x21, 0x7e, 0x49, 0xf3, 0xf6,
+	0xe7, 0x00, 0xea, 0xc1, 0xa3, 0x00, 0x47, 0xe8, 0x63, 0x28, 0x11, 0x57, 0x57, 0x36, 0x95, 0xad,
+	0x6a, 0xf7, 0xdd, 0x93, 0x89, 0x51, 0xea, 0xed, 0x4d, 0x27, 0x06, 0x7c, 0x96, 0xd8, 0xee, 0xad,
+	0xf6, 0x1d, 0xe2, 0xb6, 0xff, 0x3b, 0x31, 0x8c, 0x02, 0xb9, 0x63, 0x8f, 0x3d, 0x3c, 0x1e, 0x44,
+	0x76, 0x38, 0xdc, 0xe6, 0x46, 0x9d, 0x9e, 0xd9, 0x33, 0x4b, 0xc4, 0x45, 0x03, 0x00, 0x27, 0xc2,
+	0x76, 0x8c, 0x5d, 0xcb, 0x8e, 0xf5, 0xd5, 0x4d, 0x65, 0xab, 0x76, 0xa3, 0xd9, 0x91, 0xfb, 0xee,
+	0x64, 0xbb, 0xe9, 0x3c, 0xcc, 0xf6, 0xdd, 0x7d, 0xe7, 0xc9, 0xc4, 0x50, 0xa6, 0x13, 0x63, 0x53,
+	0xba, 0x62, 0xce, 0x10, 0xfb, 0xf6, 0xad, 0x94, 0x62, 0x27, 0x7e, 0x87, 0x86, 0x31, 0xa1, 0x81,
+	0xed, 0xb5, 0xbf, 0xf8, 0x97, 0xa1, 0x98, 0xd5, 0x5c, 0xc1, 0x1d, 0x25, 0xa1, 0x9b, 0x39, 0x2a,
+	0xbf, 0xa0, 0xa3, 0x94, 0x62, 0xd1, 0x51, 0xae, 0x40, 0x77, 0x61, 0xdd, 0xa3, 0x8e, 0xed, 0x59,
+	0xc4, 0xd5, 0x55, 0x71, 0x40, 0xd7, 0x4f, 0x26, 0xc6, 0xda, 0x3e, 0x97, 0x89, 0x53, 0x6a, 0xcd,
+	0x31, 0x0a, 0xdb, 0x9e, 0x3b, 0xe3, 0x33, 0xd7, 0x52, 0x11, 0xba, 0x07, 0xe5, 0x11, 0x09, 0x5c,
+	0x1d, 0x36, 0x95, 0xad, 0x8d, 0x1b, 0x7a, 0xa7, 0x98, 0xdb, 0x8e, 0xc8, 0x43, 0xe7, 0x17, 0x24,
+	0x70, 0xbb, 0xc6, 0x74, 0x62, 0x7c, 0x7f, 0x8e, 0x94, 0xc3, 0x0a, 0x8c, 0x82, 0x06, 0xed, 0x02,
+	0xb0, 0x21, 0x8d, 0x62, 0x2b, 0xb0, 0x7d, 0xac, 0xd7, 0xc4, 0xd6, 0x7e, 0xb0, 0x10, 0xa1, 0x30,
+	0xf9, 0xa5, 0xed, 0xe3, 0x02, 0xbe, 0x9a, 0x0b, 0xd1, 0x1d, 0xa8, 0x1e, 0x25, 0x9e, 0x27, 0x39,
+	0xea, 0x82, 0xe3, 0xea, 0x74, 0x62, 0x18, 0x73, 0x1c, 0xdc, 0xe2, 0x14, 0xc5, 0x7a, 0x26, 0x43,
+	0x07, 0x50, 0x71, 0x23, 0x72, 0x8c, 0x23, 0xbd, 0x21, 0xe2, 0xba, 0x3c, 0x1f, 0xd7, 0x9e, 0xd0,
+	0x75, 0xaf, 0x4c, 0x27, 0xc6, 0x1b, 0x73, 0xa4, 0x12, 0x54, 0xa0, 0x4c, 0x69, 0xd0, 0x6d, 0x58,
+	0x1f, 0x52, 0x1f, 0x87, 0xf6, 0x00, 0xeb, 0x1b, 0xcf, 0xd8, 0x51, 0x66, 0x50, 0xdc, 0x51, 0x26,
+	0x43, 0x77, 0xa1, 0xe6, 0x62, 0xe6, 0x44, 0x44, 0xe8, 0xf4, 0x0b, 0x82, 0xe3, 0x87, 0xd3, 0x89,
+	0xd1, 0x9e, 0xdf, 0xc0, 0xcc, 0xa6, 0x40, 0x53, 0x84, 0xa2, 0x23, 0xa8, 0x1d, 0xd1, 0x68, 0x64,
+	0xb1, 0xd8, 0x8e, 0x13, 0xa6, 0x6b, 0x22, 0xc0, 0xd6, 0x59, 0x89, 0xfb, 0x88, 0x46, 0xa3, 0x07,
+	0xc2, 0xaa, 0xfb, 0xe6, 0x74, 0x62, 0x5c, 0x99, 0x3f, 0xbf, 0x5c, 0x59, 0x70, 0x04, 0x33, 0x29,
+	0xba, 0x0f, 0x60, 0x1f, 0xdb, 0xb1, 0x1d, 0x59, 0x49, 0xe4, 0xe9, 0x17, 0xc5, 0x86, 0x7f, 0x7c,
+	0x32, 0x31, 0xaa, 0x3b, 0x42, 0x7a, 0x68, 0xee, 0x2f, 0xe4, 0x55, 0xda, 0x1f, 0x46, 0x5e, 0x31,
+	0xaf, 0xb9, 0x10, 0xdd, 0x04, 0x15, 0xfb, 0x36, 0xf1, 0x74, 0x24, 0xc8, 0x36, 0xa7, 0x13, 0xe3,
+	0xf5, 0x39, 0xbc, 0xd0, 0x16, 0xb0, 0xd2, 0x1c, 0x7d, 0x02, 0xd5, 0xa1, 0xcd, 0x2c, 0xca, 0x83,
+	0xd2, 0x5d, 0x81, 0xbd, 0x3d, 0x9d, 0x18, 0xba, 0xc4, 0x0e, 0x6d, 0x26, 0xc2, 0x9d, 0xe1, 0xce,
+	0x53, 0x17, 0xd6, 0x33, 0x58, 0xfb, 0x10, 0xca, 0xfc, 0x86, 0xa3, 0x0b, 0x50, 0x3b, 0x0c, 0x46,
+	0x01, 0x7d, 0x14, 0xf0, 0xa5, 0xb6, 0x82, 0xd6, 0xa1, 0x7c, 0xc8, 0x70, 0xa4, 0x29, 0x48, 0x83,
+	0xfa, 0x41, 0x34, 0xb0, 0x03, 0xf2, 0xd8, 0xe6, 0x2e, 0xb4, 0x12, 0xd7, 0x3d, 0xc4, 0xb6, 0xaf,
+	0xad, 0xf2, 0x5f, 0x26, 0x0e, 0xa9, 0x56, 0x46, 0x75, 0x58, 0xbf, 0x1f, 0xd1, 0x63, 0xe2, 0xe2,
+	0x48, 0x53, 0xdb, 0xef, 0x03, 0xcc, 0xce, 0x1f, 0xbd, 0x02, 0x17, 0x53, 0xf2, 0x99, 0x50, 0x5b,
+	0x41, 0x00, 0x95, 0x1e, 0xe3, 0x12, 0x4d, 0xe1, 0xf0, 0x1e, 0x7b, 0x40, 0x93, 0xc8, 0xc1, 0x5a,
+	0xa9, 0xfd, 0xe7, 0xcb, 0x50, 0x7e, 0x68, 0xb3, 0xd1, 0x77, 0x55, 0xf0, 0x9b, 0xa8, 0x82, 0xfb,
+	0x73, 0x55, 0xf0, 0xb5, 0xf9, 0xc7, 0xc4, 0xd3, 0xb0, 0x54, 0x11, 0xbc, 0x09, 0x6a, 0x4c, 0x62,
+	0x2f, 0xab, 0x7f, 0x8b, 0xf7, 0x5c, 0x68, 0x8b, 0xf7, 0x5c, 0x08, 0x4e, 0xd7, 0x88, 0xfa, 0x8b,
+	0xd7, 0x88, 0x97, 0x5e, 0xff, 0x7e, 0x0f, 0x15, 0x37, 0xc1, 0x16, 0x0d, 0xf4, 0x8d, 0xe7, 0xe6,
+	0x73, 0x2b, 0xcd, 0xe7, 0x7c, 0xcc, 0x6e, 0x82, 0x0f, 0x82, 0x53, 0xb9, 0x54, 0x85, 0x10, 0xf9,
+	0x50, 0x77, 0xa8, 0x1f, 0x7a, 0x38, 0xbd, 0x32, 0x17, 0x9e, 0xeb, 0xa2, 0x93, 0xba, 0x98, 0x3f,
+	0x98, 0x9c, 0x64, 0xe1, 0xd2, 0xd4, 0x0a, 0x2a, 0x74, 0x1f, 0x54, 0x5e, 0x3b, 0xb1, 0xae, 0x9d,
+	0xd5, 0xf3, 0x44, 0xb6, 0xf9, 0x03, 0xc5, 0x67, 0x24, 0x4e, 0xe0, 0x8a, 0x89, 0x13, 0x02, 0xb4,
+	0x07, 0x55, 0xc2, 0x2c, 0x8f, 0x3a, 0x23, 0xec, 0x8a, 0x4a, 0xb9, 0xde, 0xbd, 0x96, 0xee, 0x70,
+	0xbe, 0x45, 0x10, 0xb6, 0x2f, 0x8c, 0x8a, 0x2d, 0x22, 0x93, 0xa1, 0x1e, 0xd4, 0x83, 0xc4, 0xb7,
+	0x1c, 0xea, 0xfb, 0x38, 0x88, 0x99, 0xa8, 0x92, 0xea, 0x19, 0xf9, 0x0f, 0x12, 0x7f, 0x37, 0xb5,
+	0x29, 0xe6, 0xbf, 0x20, 0x46, 0x1f, 0x01, 0x5f, 0x5a, 0x49, 0x78, 0x4c, 0x63, 0xcc, 0xf4, 0x4b,
+	0x82, 0x69, 0xb1, 0x07, 0x04, 0x89, 0x7f, 0x28, 0x4d, 0x8a, 0x3d, 0x60, 0x26, 0x45, 0xfb, 0xd0,
+	0xe0, 0x3c, 0x2e, 0x7d, 0x14, 0x48, 0xa6, 0xcb, 0x82, 0xe9, 0xda, 0x74, 0x62, 0x5c, 0x3d, 0xcd,
+	0xb4, 0x97, 0x19, 0x15, 0xb8, 0xea, 0x45, 0x39, 0xfa, 0x04, 0x10, 0x66, 0x31, 0xf1, 0x45, 0x69,
+	0x70, 0x93, 0x48, 0x14, 0x53, 0xfd, 0x15, 0xf9, 0x72, 0xa7, 0x13, 0xe3, 0xad, 0x39, 0xca, 0x45,
+	0xd3, 0x02, 0xf1, 0xc5, 0x5c, 0xbb, 0x97, 0x2a, 0x91, 0x05, 0xc0, 0xbb, 0x84, 0x9d, 0xc4, 0x43,
+	0x9a, 0xb5, 0x89, 0x3b, 0xd3, 0x89, 0xf1, 0xbd, 0xbc, 0x4d, 0xec, 0x08, 0xd5, 0x72, 0x7d, 0xa2,
+	0x9a, 0xe3, 0xe6, 0xdb, 0x10, 0x7e, 0xc9, 0x6d, 0x08, 0x0d, 0xa1, 0xc1, 0xd9, 0x7d, 0xe2, 0x61,
+	0x16, 0xd3, 0x00, 0xeb, 0x47, 0xc2, 0xc3, 0xee, 0xec, 0x0e, 0x0e, 0x6d, 0x76, 0x2f, 0xd3, 0x2e,
+	0xe7, 0xa5, 0x5e, 0x84, 0x22, 0x0c, 0x75, 0x71, 0x50, 0x8c, 0x91, 0x41, 0x80, 0xb1, 0x3e, 0xd8,
+	0x5c, 0xdd, 0xaa, 0x76, 0xbb, 0xb3, 0xda, 0xc6, 0x43, 0x4e, 0x95, 0xcb, 0xf9, 0xa9, 0x15, 0x90,
+	0x99, 0x9b, 0x08, 0x1f, 0x13, 0xfc, 0x08, 0x47, 0xfa, 0xf0, 0x0c, 0x37, 0x66, 0xaa, 0x5c, 0xde,
+	0x4d, 0x86, 0xcc, 0xb2, 0xe2, 0xd9, 0x7d, 0xec, 0xe9, 0x64, 0x73, 0x75, 0x21, 0x2b, 0xfb, 0x5c,
+	0xb3, 0x7c, 0x56, 0x04, 0x0c, 0x79, 0x70, 0x81, 0x30, 0xcb, 0xc5, 0x21, 0x0e, 0x5c, 0x12, 0x0c,
+	0x78, 0x01, 0xfc, 0x54, 0xf8, 0xd8, 0x9b, 0xd5, 0x4e, 0xc2, 0xf6, 0x32, 0xfd, 0x41, 0xb0, 0x9c,
+	0xa3, 0xc6, 0x1c, 0x16, 0xf5, 0xa1, 0x46, 0x98, 0xd5, 0xe7, 0x85, 0x84, 0x04, 0x03, 0x7d, 0x24,
+	0x3c, 0xed, 0x4c, 0x27, 0x46, 0x33, 0xf3, 0xd4, 0x4d, 0x75, 0xcb, 0xb9, 0x81, 0x19, 0x30, 0x8d,
+	0x28, 0xc2, 0x9e, 0x78, 0x5a, 0x8f, 0x48, 0x3c, 0xd4, 0xbd, 0xc5, 0x88, 0x4c, 0xa9, 0xff, 0x0d,
+	0x89, 0x87, 0x4b, 0x47, 0x54, 0xc0, 0xa2, 0x3f, 0x00, 0x10, 0x66, 0x85, 0x76, 0x14, 0x5b, 0xf4,
+	0x48, 0xf7, 0x4f, 0xa7, 0x87, 0xb0, 0xfb, 0x76, 0x14, 0x1f, 0x1c, 0x2d, 0x99, 0x9e, 0x0c, 0x86,
+	0x7e, 0x0b, 0x3c, 0x55, 0x82, 0x5f, 0x0f, 0x04, 0xf9, 0x07, 0xd3, 0x89, 0xf1, 0x5a, 0x9e, 0x7b,
+	0x6e, 0xb6, 0x1c, 0xf7, 0x5a, 0x8a, 0x6a, 0xf7, 0x9f, 0x35, 0x16, 0x56, 0x41, 0xed, 0x31, 0x96,
+	0x60, 0x39, 0x17, 0xde, 0xc3, 0xd1, 0x00, 0x9b, 0xf8, 0xb3, 0x04, 0xb3, 0x58, 0x2b, 0xa1, 0x06,
+	0x54, 0xf3, 0x87, 0x26, 0x87, 0xc3, 0x0f, 0x43, 0xe2, 0x68, 0x65, 0x8e, 0x7a, 0x10, 0xd3, 0x68,
+	0xac, 0xa9, 0x5c, 0xb8, 0x6b, 0x47, 0xae, 0x56, 0x69, 0x6f, 0x73, 0x21, 0x6f, 0x20, 0x1a, 0xd4,
+	0x53, 0x27, 0x62, 0x2d, 0x87, 0xcf, 0x83, 0x10, 0x07, 0x9a, 0xc2, 0x67, 0xc4, 0x5d, 0x8f, 0x32,
+	0xec, 0x6a, 0xa5, 0xf6, 0x93, 0x0a, 0xa8, 0x0f, 0x69, 0x48, 0x9c, 0xef, 0xc6, 0xc2, 0x6f, 0xfd,
+	0xe3, 0x58, 0xe4, 0xe1, 0x1b, 0x99, 0x0b, 0x67, 0xd3, 0x5c, 0xfd, 0xe5, 0x4c, 0x73, 0x37, 0x41,
+	0x75, 0xa8, 0x47, 0xe5, 0x74, 0x78, 0xd6, 0x46, 0x84, 0xb6, 0xb8, 0x11, 0x21, 0x38, 0x3d, 0xa0,
+	0x6e, 0xbc, 0xf8, 0x80, 0xfa, 0xff, 0xfd, 0xa4, 0x6b, 0x7f, 0xcd, 0xdb, 0x15, 0x75, 0x5d, 0x53,
+	0xda, 0x7f, 0x52, 0x40, 0xed, 0xda, 0xb1, 0x33, 0x44, 0x5b, 0xa0, 0xc6, 0x36, 0x1b, 0x31, 0x5d,
+	0xd9, 0x5c, 0xdd, 0xaa, 0xdd, 0x40, 0x8b, 0xf3, 0xa0, 0x29, 0x0d, 0xd0, 0x8f, 0xa0, 0x22, 0x76,
+	0xcc, 0xf4, 0x92, 0x30, 0xbd, 0x74, 0xc6, 0x57, 0xb7, 0x99, 0x9a, 0x70, 0xe3, 0x98, 0x5f, 0x11,
+	0xa6, 0xaf, 0x9e, 0x65, 0x2c, 0xae, 0x8f, 0x99, 0x9a, 0xb4, 0x3f, 0xaf, 0x41, 0x95, 0xd7, 0x07,
+	0xdc, 0x0b, 0x8e, 0x28, 0xd2, 0x61, 0xad, 0x6f, 0x3b, 0x23, 0x1c, 0xa4, 0x2f, 0xdc, 0xcc, 0x96,
+	0xa8, 0x29, 0xef, 0xb6, 0x38, 0xfe, 0x92, 0x50, 0xe5, 0x6b, 0xf4, 0x06, 0x00, 0x23, 0x8f, 0xb1,
+	0xd5, 0x1f, 0xf3, 0x49, 0x8d, 0xbf, 0xe4, 0x55, 0xb3, 0xca, 0x25, 0x5d, 0x2e, 0x40, 0x97, 0x41,
+	0xe5, 0x47, 0xc5, 0xc4, 0xd3, 0x5b, 0x35, 0xe5, 0x02, 0xbd, 0x9a, 0x87, 0xa4, 0x0a, 0x71, 0xb6,
+	0xfb, 0xcb, 0xd9, 0xa1, 0x54, 0xa4, 0xb5, 0x3c, 0x80, 0x57, 0xf3, 0x98, 0xd6, 0xa4, 0xb5, 0x5c,
+	0xa1, 0xfb, 0xd0, 0x10, 0x1d, 0x85, 0xd0, 0x80, 0x0d, 0x49, 0xc8, 0xf4, 0x75, 0x11, 0xf2, 0xdb,
+	0xf3, 0x21, 0xe7, 0x01, 0x76, 0xcc, 0xa2, 0xf1, 0x87, 0x41, 0x1c, 0x8d, 0xcd, 0x79, 0x02, 0x74,
+	0x03, 0xd4, 0x08, 0x87, 0x94, 0xe9, 0x55, 0xc1, 0xf4, 0xfa, 0xb3, 0x99, 0x42, 0x6a, 0x4a, 0x53,
+	0x64, 0xc2, 0x25, 0xd7, 0x0e, 0x06, 0x1e, 0x6f, 0xd4, 0x11, 0x3e, 0xc2, 0x11, 0x0e, 0x1c, 0xcc,
+	0x74, 0x10, 0x0c, 0x57, 0x9e, 0xcd, 0x90, 0x5a, 0x9a, 0x28, 0x43, 0xe7, 0x22, 0x3e, 0x01, 0x5f,
+	0xf0, 0x6c, 0x16, 0x5b, 0x85, 0xd2, 0x55, 0x7b, 0x6e, 0xe9, 0x5a, 0xe7, 0xa5, 0x4b, 0x94, 0xa5,
+	0x06, 0x07, 0x1f, 0xe6, 0xa5, 0xe9, 0xe7, 0xb0, 0x21, 0xd8, 0xd8, 0x38, 0x70, 0x24, 0x59, 0x7d,
+	0x09, 0xb2, 0x3a, 0xc7, 0x3e, 0x10, 0xd0, 0x9d, 0x18, 0xbd, 0x09, 0x1b, 0xf2, 0xbd, 0x59, 0xc7,
+	0x38, 0x62, 0xfc, 0x42, 0xf0, 0xd7, 0xac, 0x9a, 0x0d, 0x29, 0xfd, 0xb5, 0x14, 0x36, 0xff, 0x52,
+	0x96, 0x7f, 0xa0, 0x40, 0xef, 0x15, 0x3a, 0xc6, 0x5b, 0xb2, 0x63, 0x9c, 0xb7, 0x47, 0xe4, 0x97,
+	0xa1, 0x54, 0xbc, 0x0c, 0xbf, 0x82, 0x86, 0xf8, 0x61, 0xf5, 0xc7, 0x96, 0x28, 0x93, 0xf2, 0x9e,
+	0x5f, 0xff, 0xba, 0x54, 0x89, 0xe7, 0xc4, 0xba, 0x63, 0xfe, 0x1e, 0x65, 0xde, 0x6b, 0xf1, 0x4c,
+	0x82, 0x1e, 0xc2, 0x46, 0x4e, 0x29, 0xbf, 0xd1, 0xca, 0x82, 0xb3, 0x73, 0x1e, 0x4e, 0xd1, 0x3a,
+	0x25, 0x69, 0x3d, 0x2e, 0x88, 0xce, 0xca, 0xa1, 0xfa, 0x32, 0x73, 0x58, 0x79, 0xd1, 0x1c, 0x36,
+	0x3f, 0x00, 0xed, 0xf4, 0x81, 0x20, 0x0d, 0x56, 0x47, 0x78, 0x9c, 0x3e, 0x7c, 0xfe, 0x93, 0x1f,
+	0xff, 0xb1, 0xed, 0x25, 0x38, 0x3b, 0x7e, 0xb1, 0xb8, 0x55, 0xfa, 0xa9, 0xd2, 0xbc, 0x0d, 0x17,
+	0x17, 0x82, 0x5f, 0x8a, 0xe0, 0xef, 0x0a, 0x54, 0xf3, 0xdb, 0x8e, 0xde, 0x87, 0x35, 0x96, 0xf4,
+	0x3f, 0xc5, 0x4e, 0x9c, 0xde, 0x93, 0xab, 0xe7, 0x1a, 0x99, 0x52, 0x0c, 0xda, 0x81, 0x6a, 0x18,
+	0x61, 0x97, 0x38, 0x76, 0x2c, 0x5d, 0x9d, 0x93, 0x60, 0x86, 0x42, 0x3f, 0x83, 0x0a, 0x95, 0x1b,
+	0x58, 0x3d, 0x3f, 0x3e, 0x85, 0x34, 0xef, 0x00, 0x5a, 0x2c, 0x2c, 0xcb, 0x1c, 0x47, 0xfb, 0x1f,
+	0x25, 0x50, 0x77, 0xc7, 0x8e, 0x87, 0xd1, 0xbb, 0x50, 0x0e, 0xed, 0x78, 0x28, 0x7a, 0xc2, 0x39,
+	0xb7, 0x21, 0x00, 0xe8, 0xbd, 0xd9, 0x5b, 0x39, 0x37, 0x32, 0x7d, 0x50, 0x5d, 0xa8, 0xcb, 0x2f,
+	0x0d, 0x1c, 0x38, 0x04, 0x67, 0x7d, 0xe3, 0xd4, 0x9f, 0x76, 0xc5, 0xf6, 0x3a, 0x7b, 0x99, 0xdd,
+	0xd8, 0x9c, 0xc3, 0x34, 0xff, 0xa8, 0x00, 0xcc, 0x94, 0x3c, 0x0c, 0xce, 0xbd, 0x4c, 0x3a, 0x05,
+	0x00, 0x75, 0x01, 0x24, 0x2f, 0xb3, 0xb2, 0x56, 0x73, 0xce, 0x64, 0xa6, 0xb0, 0x83, 0xe0, 0xed,
+	0x6b, 0x50, 0x91, 0xb3, 0x09, 0xba, 0x08, 0x8d, 0xb4, 0x11, 0x4b, 0x81, 0xfc, 0xd3, 0xe7, 0xc7,
+	0x24, 0xbe, 0x9b, 0xf4, 0x35, 0xa5, 0xdb, 0x7b, 0xf2, 0x55, 0x6b, 0xe5, 0x3f, 0x5f, 0xb5, 0x56,
+	0xfe, 0x7a, 0xd2, 0x5a, 0x79, 0x72, 0xd2, 0x52, 0xbe, 0x3c, 0x69, 0x29, 0xff, 0x3e, 0x69, 0x29,
+	0x5f, 0x3c, 0x6d, 0xad, 0xfc, 0xed, 0x69, 0x4b, 0xf9, 0xf2, 0x69, 0x6b, 0xe5, 0x9f, 0x4f, 0x5b,
+	0x2b, 0xbf, 0x33, 0x7c, 0x9a, 0x78, 0x1d, 0x42, 0xb7, 0xe5, 0x99, 0x6c, 0x93, 0x20, 0xc6, 0x51,
+	0x60, 0x7b, 0xdb, 0xe9, 0xbf, 0xa8, 0xfa, 0x15, 0xf1, 0xfa, 0x7e, 0xf2, 0xbf, 0x01, 0x00, 0x70,
+	0x0a, 0x88, 0x96, 0xb4, 0x1a, 0x00, 0x00,
 }
 
 func (m *Owner) Marshal() (dAtA []byte, err error) {
@@ -576,6 +834,15 @@ func (m *Owner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.AvatarURL) > 0 {
 		i -= len(m.AvatarURL)
 		copy(dAtA[i:], m.AvatarURL)
@@ -1094,101 +1361,462 @@ func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDvmodel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDvmodel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StoreInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Owner) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StoreInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.SchemaVersion != 0 {
+		i = encodeVarintDvmodel(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.LastSyncedAt != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSyncedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSyncedAt):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintDvmodel(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.LastUpdatedAt != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdatedAt):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintDvmodel(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.LocalID)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
+	if len(m.DanglingReferences) > 0 {
+		for iNdEx := len(m.DanglingReferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DanglingReferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDvmodel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.Kind != 0 {
-		n += 1 + sovDvmodel(uint64(m.Kind))
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Repos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDvmodel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.ShortName)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
+	if len(m.Relationships) > 0 {
+		for k := range m.Relationships {
+			v := m.Relationships[k]
+			baseI := i
+			i = encodeVarintDvmodel(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDvmodel(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDvmodel(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.FullName)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.Topics != 0 {
+		i = encodeVarintDvmodel(dAtA, i, uint64(m.Topics))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Driver != 0 {
-		n += 1 + sovDvmodel(uint64(m.Driver))
+	if m.Tasks != 0 {
+		i = encodeVarintDvmodel(dAtA, i, uint64(m.Tasks))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Homepage)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.Owners != 0 {
+		i = encodeVarintDvmodel(dAtA, i, uint64(m.Owners))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.Quads != 0 {
+		i = encodeVarintDvmodel(dAtA, i, uint64(m.Quads))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ForkStatus != 0 {
-		n += 2 + sovDvmodel(uint64(m.ForkStatus))
+	if m.SizeBytes != 0 {
+		i = encodeVarintDvmodel(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.AvatarURL)
-	if l > 0 {
-		n += 2 + l + sovDvmodel(uint64(l))
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.HasOwner)
-	if l > 0 {
-		n += 2 + l + sovDvmodel(uint64(l))
+	if len(m.Backend) > 0 {
+		i -= len(m.Backend)
+		copy(dAtA[i:], m.Backend)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Backend)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Task) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StoreInfo_Repo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreInfo_Repo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo_Repo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
-		n += 1 + l + sovDvmodel(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.LastSyncedAt != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSyncedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSyncedAt):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintDvmodel(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.LocalID)
-	if l > 0 {
-		n += 1 + l + sovDvmodel(uint64(l))
+	if m.LastUpdatedAt != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdatedAt):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintDvmodel(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Kind != 0 {
-		n += 1 + sovDvmodel(uint64(m.Kind))
+	if len(m.TasksByState) > 0 {
+		for k := range m.TasksByState {
+			v := m.TasksByState[k]
+			baseI := i
+			i = encodeVarintDvmodel(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDvmodel(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDvmodel(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Title)
+	if len(m.TasksByKind) > 0 {
+		for k := range m.TasksByKind {
+			v := m.TasksByKind[k]
+			baseI := i
+			i = encodeVarintDvmodel(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDvmodel(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDvmodel(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Tasks != 0 {
+		i = encodeVarintDvmodel(dAtA, i, uint64(m.Tasks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreInfo_Reference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreInfo_Reference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo_Reference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Object) > 0 {
+		i -= len(m.Object)
+		copy(dAtA[i:], m.Object)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Object)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDvmodel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tasks[iNdEx])
+			copy(dAtA[i:], m.Tasks[iNdEx])
+			i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Tasks[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Path[iNdEx])
+			copy(dAtA[i:], m.Path[iNdEx])
+			i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Path[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cycle_Dependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cycle_Dependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cycle_Dependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DependsOn) > 0 {
+		i -= len(m.DependsOn)
+		copy(dAtA[i:], m.DependsOn)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.DependsOn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDvmodel(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDvmodel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDvmodel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Owner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.LocalID)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovDvmodel(uint64(m.Kind))
+	}
+	l = len(m.ShortName)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.FullName)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.Driver != 0 {
+		n += 1 + sovDvmodel(uint64(m.Driver))
+	}
+	l = len(m.Homepage)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.ForkStatus != 0 {
+		n += 2 + sovDvmodel(uint64(m.ForkStatus))
+	}
+	l = len(m.AvatarURL)
+	if l > 0 {
+		n += 2 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 2 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.HasOwner)
+	if l > 0 {
+		n += 2 + l + sovDvmodel(uint64(l))
+	}
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.LocalID)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovDvmodel(uint64(m.Kind))
+	}
+	l = len(m.Title)
 	if l > 0 {
 		n += 1 + l + sovDvmodel(uint64(l))
 	}
@@ -1363,36 +1991,204 @@ func (m *Batch) Size() (n int) {
 	return n
 }
 
-func sovDvmodel(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDvmodel(x uint64) (n int) {
-	return sovDvmodel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Owner) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDvmodel
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (m *StoreInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovDvmodel(uint64(m.SizeBytes))
+	}
+	if m.Quads != 0 {
+		n += 1 + sovDvmodel(uint64(m.Quads))
+	}
+	if m.Owners != 0 {
+		n += 1 + sovDvmodel(uint64(m.Owners))
+	}
+	if m.Tasks != 0 {
+		n += 1 + sovDvmodel(uint64(m.Tasks))
+	}
+	if m.Topics != 0 {
+		n += 1 + sovDvmodel(uint64(m.Topics))
+	}
+	if len(m.Relationships) > 0 {
+		for k, v := range m.Relationships {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDvmodel(uint64(len(k))) + 1 + sovDvmodel(uint64(v))
+			n += mapEntrySize + 1 + sovDvmodel(uint64(mapEntrySize))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
+	}
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovDvmodel(uint64(l))
+		}
+	}
+	if len(m.DanglingReferences) > 0 {
+		for _, e := range m.DanglingReferences {
+			l = e.Size()
+			n += 1 + l + sovDvmodel(uint64(l))
+		}
+	}
+	if m.LastUpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdatedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.LastSyncedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSyncedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovDvmodel(uint64(m.SchemaVersion))
+	}
+	return n
+}
+
+func (m *StoreInfo_Repo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.Tasks != 0 {
+		n += 1 + sovDvmodel(uint64(m.Tasks))
+	}
+	if len(m.TasksByKind) > 0 {
+		for k, v := range m.TasksByKind {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDvmodel(uint64(len(k))) + 1 + sovDvmodel(uint64(v))
+			n += mapEntrySize + 1 + sovDvmodel(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TasksByState) > 0 {
+		for k, v := range m.TasksByState {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDvmodel(uint64(len(k))) + 1 + sovDvmodel(uint64(v))
+			n += mapEntrySize + 1 + sovDvmodel(uint64(mapEntrySize))
+		}
+	}
+	if m.LastUpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdatedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	if m.LastSyncedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSyncedAt)
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	return n
+}
+
+func (m *StoreInfo_Reference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	return n
+}
+
+func (m *Cycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		for _, s := range m.Path {
+			l = len(s)
+			n += 1 + l + sovDvmodel(uint64(l))
+		}
+	}
+	if len(m.Tasks) > 0 {
+		for _, s := range m.Tasks {
+			l = len(s)
+			n += 1 + l + sovDvmodel(uint64(l))
+		}
+	}
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 1 + l + sovDvmodel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Cycle_Dependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	l = len(m.DependsOn)
+	if l > 0 {
+		n += 1 + l + sovDvmodel(uint64(l))
+	}
+	return n
+}
+
+func sovDvmodel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDvmodel(x uint64) (n int) {
+	return sovDvmodel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Owner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvmodel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -1751,6 +2547,38 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 			}
 			m.AvatarURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HasOwner", wireType)
@@ -2305,7 +3133,881 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasOwner = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMilestone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasMilestone = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAssignee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasAssignee = append(m.HasAssignee, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReviewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasReviewer = append(m.HasReviewer, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasLabel = append(m.HasLabel, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDependingOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsDependingOn = append(m.IsDependingOn, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBlocking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsBlocking = append(m.IsBlocking, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRelatedWith", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsRelatedWith = append(m.IsRelatedWith, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPartOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsPartOf = append(m.IsPartOf, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasPart = append(m.HasPart, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvmodel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Topic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvmodel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= Topic_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			}
+			m.Driver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Driver |= Driver(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HasOwner = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvmodel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Batch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvmodel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, &Owner{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &Topic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvmodel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvmodel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2333,11 +4035,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasOwner = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			m.Backend = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMilestone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2365,13 +4067,13 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasMilestone = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			m.Location = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasAssignee", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2381,29 +4083,35 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDvmodel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDvmodel
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quads", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Quads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quads |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.HasAssignee = append(m.HasAssignee, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 104:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasReviewer", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
-			var stringLen uint64
+			m.Owners = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2413,29 +4121,54 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Owners |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDvmodel
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDvmodel
+			m.Tasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tasks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			m.HasReviewer = append(m.HasReviewer, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 105:
+			m.Topics = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Topics |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relationships", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2445,29 +4178,110 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasLabel = append(m.HasLabel, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			if m.Relationships == nil {
+				m.Relationships = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDvmodel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDvmodel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDvmodel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDvmodel(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Relationships[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 106:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDependingOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2477,29 +4291,31 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsDependingOn = append(m.IsDependingOn, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			m.Repos = append(m.Repos, &StoreInfo_Repo{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 107:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBlocking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DanglingReferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2509,29 +4325,31 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsBlocking = append(m.IsBlocking, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			m.DanglingReferences = append(m.DanglingReferences, &StoreInfo_Reference{})
+			if err := m.DanglingReferences[len(m.DanglingReferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 108:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRelatedWith", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2541,29 +4359,33 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsRelatedWith = append(m.IsRelatedWith, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			if m.LastUpdatedAt == nil {
+				m.LastUpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 109:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPartOf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2573,29 +4395,33 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsPartOf = append(m.IsPartOf, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
+			if m.LastSyncedAt == nil {
+				m.LastSyncedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSyncedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 110:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasPart", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
 			}
-			var stringLen uint64
+			m.SchemaVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2605,24 +4431,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SchemaVersion |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDvmodel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDvmodel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HasPart = append(m.HasPart, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDvmodel(dAtA[iNdEx:])
@@ -2644,7 +4457,7 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Topic) Unmarshal(dAtA []byte) error {
+func (m *StoreInfo_Repo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2667,17 +4480,181 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			m.Tasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tasks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksByKind", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvmodel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TasksByKind == nil {
+				m.TasksByKind = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDvmodel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDvmodel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDvmodel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDvmodel(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TasksByKind[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksByState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2687,27 +4664,108 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			if m.TasksByState == nil {
+				m.TasksByState = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDvmodel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDvmodel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDvmodel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDvmodel(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDvmodel
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TasksByState[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2734,16 +4792,16 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = new(time.Time)
+			if m.LastUpdatedAt == nil {
+				m.LastUpdatedAt = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2770,16 +4828,66 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = new(time.Time)
+			if m.LastSyncedAt == nil {
+				m.LastSyncedAt = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSyncedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvmodel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreInfo_Reference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvmodel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2807,13 +4915,13 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalID = string(dAtA[iNdEx:postIndex])
+			m.Subject = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			m.Kind = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2823,14 +4931,27 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= Topic_Kind(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvmodel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2858,30 +4979,61 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Object = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvmodel(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Driver = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDvmodel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Driver |= Driver(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvmodel
 			}
-		case 13:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvmodel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2909,11 +5061,11 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Color = string(dAtA[iNdEx:postIndex])
+			m.Path = append(m.Path, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2941,13 +5093,13 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Tasks = append(m.Tasks, github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 100:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -2957,23 +5109,25 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HasOwner = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, &Cycle_Dependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2996,7 +5150,7 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Batch) Unmarshal(dAtA []byte) error {
+func (m *Cycle_Dependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3019,17 +5173,17 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -3039,65 +5193,29 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &Task{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Task = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDvmodel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDvmodel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDvmodel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owners = append(m.Owners, &Owner{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvmodel
@@ -3107,25 +5225,23 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDvmodel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvmodel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &Topic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DependsOn = github_com_cayleygraph_quad.IRI(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
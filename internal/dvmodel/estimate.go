@@ -0,0 +1,33 @@
+package dvmodel
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	str2duration "github.com/xhit/go-str2duration/v2"
+)
+
+// ParseEstimate parses a Task.EstimatedDuration, either a single duration, e.g. "2d", "1w3d" or "4h",
+// or a three-point estimate with the optimistic, most likely and pessimistic durations, e.g. "2d/3d/1w".
+func ParseEstimate(input string) ([]time.Duration, error) {
+	parts := strings.Split(input, "/")
+	if len(parts) != 1 && len(parts) != 3 {
+		return nil, fmt.Errorf("invalid estimate %q: expected one or three durations", input)
+	}
+	estimate := make([]time.Duration, 0, len(parts))
+	for _, part := range parts {
+		duration, err := str2duration.ParseDuration(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid estimate %q: %w", input, err)
+		}
+		if duration < 0 {
+			return nil, fmt.Errorf("invalid estimate %q: negative duration", input)
+		}
+		estimate = append(estimate, duration)
+	}
+	if len(estimate) == 3 && (estimate[0] > estimate[1] || estimate[1] > estimate[2]) {
+		return nil, fmt.Errorf("invalid estimate %q: expected optimistic/most likely/pessimistic durations", input)
+	}
+	return estimate, nil
+}
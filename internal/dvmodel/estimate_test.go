@@ -0,0 +1,38 @@
+package dvmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEstimate(t *testing.T) {
+	const day = 24 * time.Hour
+	tests := []struct {
+		input    string
+		expected []time.Duration
+	}{
+		{"2d", []time.Duration{2 * day}},
+		{"1w3d", []time.Duration{10 * day}},
+		{"4h", []time.Duration{4 * time.Hour}},
+		{"2d/3d/1w", []time.Duration{2 * day, 3 * day, 7 * day}},
+		{"1d/1d/1d", []time.Duration{day, day, day}},
+		{"", nil},
+		{"2d/3d", nil},
+		{"1d/2d/3d/4d", nil},
+		{"3d/2d/1w", nil}, // not ordered
+		{"-2d", nil},
+		{"undefined", nil},
+		{"invalid", nil},
+	}
+	for _, test := range tests {
+		estimate, err := ParseEstimate(test.input)
+		if test.expected == nil {
+			assert.Error(t, err, test.input)
+			continue
+		}
+		assert.NoError(t, err, test.input)
+		assert.Equal(t, test.expected, estimate, test.input)
+	}
+}
@@ -18,44 +18,54 @@ func (t *Task) AllDeps() []quad.IRI {
 	return allDeps
 }
 
-func FilterIsolatedTasks(in []Task, logger *zap.Logger) []Task {
-	uniqueDeps := map[quad.IRI]*Task{}
+// AllRelations returns the tasks linked to t by a dependency-like relationship.
+func (t *Task) AllRelations() []quad.IRI {
+	relations := []quad.IRI{}
+	for _, iris := range [][]quad.IRI{t.IsDependingOn, t.IsBlocking, t.IsRelatedWith, t.IsPartOf, t.HasPart} {
+		relations = append(relations, iris...)
+	}
+	return relations
+}
 
-	for _, task := range in {
-		for _, dep := range task.IsDependingOn {
-			uniqueDeps[dep] = nil
-		}
-		for _, dep := range task.IsBlocking {
-			uniqueDeps[dep] = nil
-		}
-		for _, dep := range task.IsRelatedWith {
-			uniqueDeps[dep] = nil
-		}
-		for _, dep := range task.IsPartOf {
-			uniqueDeps[dep] = nil
-		}
-		for _, dep := range task.HasPart {
-			uniqueDeps[dep] = nil
+// AllReferences returns every entity referenced by t.
+func (t *Task) AllReferences() []quad.IRI {
+	references := []quad.IRI{}
+	for _, iri := range []quad.IRI{t.HasAuthor, t.HasOwner, t.HasMilestone} {
+		if iri != "" {
+			references = append(references, iri)
 		}
 	}
+	for _, iris := range [][]quad.IRI{t.HasAssignee, t.HasReviewer, t.HasLabel} {
+		references = append(references, iris...)
+	}
+	return append(references, t.AllRelations()...)
+}
 
-	for _, task := range in {
-		taskCopy := task
-		if _, found := uniqueDeps[task.ID]; found {
-			uniqueDeps[task.ID] = &taskCopy
+// TargetedTasks returns the IDs of the tasks targeted by a relationship of one of tasks, see AllRelations.
+func TargetedTasks(tasks []Task) map[quad.IRI]bool {
+	targeted := map[quad.IRI]bool{}
+	for _, task := range tasks {
+		for _, relation := range task.AllRelations() {
+			targeted[relation] = true
 		}
 	}
+	return targeted
+}
 
-	out := make([]Task, len(uniqueDeps))
-	i := 0
-	for key, dep := range uniqueDeps {
-		if dep == nil {
-			logger.Warn("nil dep", zap.Any("key", key))
-		} else {
-			out[i] = *dep
-			i++
+// FilterIsolatedTasks only keeps the tasks targeted by a relationship of another task of in, see TargetedTasks.
+func FilterIsolatedTasks(in []Task, logger *zap.Logger) []Task {
+	targeted := TargetedTasks(in)
+
+	out := []Task{}
+	for _, task := range in {
+		if targeted[task.ID] {
+			out = append(out, task)
+			delete(targeted, task.ID)
 		}
 	}
+	for key := range targeted {
+		logger.Warn("nil dep", zap.Any("key", key))
+	}
 
 	return out
 }
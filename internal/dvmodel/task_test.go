@@ -0,0 +1,29 @@
+package dvmodel
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestFilterIsolatedTasks(t *testing.T) {
+	tasks := []Task{
+		{ID: "https://example.com/1", IsDependingOn: []quad.IRI{"https://example.com/2", "https://example.com/unknown"}},
+		{ID: "https://example.com/2"},
+		{ID: "https://example.com/3", IsBlocking: []quad.IRI{"https://example.com/4"}},
+		{ID: "https://example.com/4", HasPart: []quad.IRI{"https://example.com/missing"}},
+		{ID: "https://example.com/isolated"},
+	}
+
+	// the relationships pointing at tasks missing from the input must not leave empty tasks in the output
+	filtered := FilterIsolatedTasks(tasks, testutil.Logger(t))
+	ids := []string{}
+	for _, task := range filtered {
+		ids = append(ids, string(task.ID))
+	}
+	sort.Strings(ids)
+	assert.Equal(t, []string{"https://example.com/2", "https://example.com/4"}, ids)
+}
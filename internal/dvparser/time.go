@@ -0,0 +1,18 @@
+package dvparser
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseAsOf parses an RFC 3339 time or a YYYY-MM-DD date, meaning the end of that day (UTC).
+func ParseAsOf(input string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, input); err == nil {
+		return t, nil
+	}
+	day, err := time.Parse("2006-01-02", input)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %q (YYYY-MM-DD or RFC 3339)", input)
+	}
+	return day.Add(24*time.Hour - time.Nanosecond), nil
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/cayleygraph/quad"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 	"moul.io/depviz/v3/internal/dvcore"
@@ -125,6 +126,25 @@ func (s *service) Graph(ctx context.Context, in *Graph_Input) (*Graph_Output, er
 		WithoutPRs:          in.WithoutPRs,
 		WithoutExternalDeps: in.WithoutExternalDeps,
 		WithFetch:           in.WithFetch,
+		After:               quad.IRI(in.Cursor),
+		Limit:               int(in.Limit),
+		DepsDepth:           int(in.DepsDepth),
+		Index:               s.index,
+	}
+	filters.DepsDirection, err = dvstore.ParseDepsDirection(in.DepsDirection)
+	if err != nil {
+		return nil, err
+	}
+	filters.Filter, err = dvstore.ParseTaskFilter(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if in.AsOf != "" {
+		asOf, err := dvparser.ParseAsOf(in.AsOf)
+		if err != nil {
+			return nil, err
+		}
+		filters.AsOf = &asOf
 	}
 	if len(in.Targets) == 1 && in.Targets[0] == "world" {
 		filters.TheWorld = true
@@ -138,41 +158,84 @@ func (s *service) Graph(ctx context.Context, in *Graph_Input) (*Graph_Output, er
 
 	// load tasks
 	if filters.WithFetch && gitHubToken != "" {
-		_, err := dvcore.PullAndSave(filters.Targets, s.h, s.schema, gitHubToken, false, s.opts.Logger)
+		_, err := dvcore.PullAndSave(filters.Targets, s.h, s.schema, gitHubToken, false, s.opts.Snapshots, s.index, s.opts.Logger)
 		if err != nil {
 			return nil, fmt.Errorf("pull: %w", err)
 		}
 	}
 
-	var tasks dvmodel.Tasks
-	tasks, err = dvstore.LoadTasks(s.h, s.schema, filters, s.opts.Logger)
+	page, err := dvstore.LoadTasksPage(s.h, s.schema, filters, s.opts.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("load tasks: %w", err)
 	}
 
 	// fetch if not already in db
-	if len(tasks) == 0 {
-		_, err := dvcore.PullAndSave(filters.Targets, s.h, s.schema, s.opts.GitHubToken, false, s.opts.Logger)
+	if len(page.Tasks) == 0 && filters.AsOf == nil && filters.After == "" {
+		_, err := dvcore.PullAndSave(filters.Targets, s.h, s.schema, s.opts.GitHubToken, false, s.opts.Snapshots, s.index, s.opts.Logger)
 		if err != nil {
 			return nil, fmt.Errorf("pull: %w", err)
 		}
-		tasks, err = dvstore.LoadTasks(s.h, s.schema, filters, s.opts.Logger)
+		page, err = dvstore.LoadTasksPage(s.h, s.schema, filters, s.opts.Logger)
 		if err != nil {
 			return nil, fmt.Errorf("load tasks: %w", err)
 		}
 	}
 
+	// the cycles are the same for every page
+	cycles := page.Tasks.Cycles()
+	if filters.After != "" || page.Truncated {
+		cycles, err = dvstore.LoadCycles(s.h, s.schema, filters, s.opts.Logger)
+		if err != nil {
+			return nil, fmt.Errorf("load cycles: %w", err)
+		}
+	}
+
 	// build output
 	ret := Graph_Output{
-		Tasks: make([]*dvmodel.Task, len(tasks)),
+		Tasks:      make([]*dvmodel.Task, len(page.Tasks)),
+		Truncated:  page.Truncated,
+		NextCursor: string(page.NextCursor),
+		Cycles:     cycles,
 	}
-	for idx, task := range tasks {
+	for idx, task := range page.Tasks {
 		clone := task
 		ret.Tasks[idx] = &clone
 	}
 	return &ret, nil
 }
 
+func (s *service) Search(ctx context.Context, in *Search_Input) (*Search_Output, error) {
+	s.opts.Logger.Debug("search", zap.Any("in", in))
+
+	opts := dvstore.SearchOpts{
+		Limit:      int(in.Limit),
+		WithClosed: in.WithClosed,
+	}
+	results, err := dvstore.Search(ctx, s.h, s.schema, in.Query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	ret := Search_Output{
+		Results: make([]*Search_Result, len(results)),
+	}
+	for idx, result := range results {
+		task := result.Task
+		ret.Results[idx] = &Search_Result{
+			Task:       &task,
+			Score:      result.Score,
+			Highlights: make([]*Search_Highlight, len(result.Highlights)),
+		}
+		for i, highlight := range result.Highlights {
+			ret.Results[idx].Highlights[i] = &Search_Highlight{
+				Field:    highlight.Field,
+				Fragment: highlight.Fragment,
+			}
+		}
+	}
+	return &ret, nil
+}
+
 func (s *service) StoreDump(ctx context.Context, in *StoreDump_Input) (*StoreDump_Output, error) {
 	if !s.opts.Godmode {
 		return nil, fmt.Errorf("permission denied (--god-mode required)")
@@ -188,6 +251,25 @@ func (s *service) StoreDump(ctx context.Context, in *StoreDump_Input) (*StoreDum
 	return &ret, nil
 }
 
+func (s *service) StoreInfo(ctx context.Context, in *StoreInfo_Input) (*StoreInfo_Output, error) {
+	opts := dvcore.StoreInfoOpts{
+		Backend:  s.opts.StoreBackend,
+		Location: s.opts.StoreLocation,
+	}
+	info, err := dvcore.GetStoreInfo(ctx, s.h, s.schema, opts)
+	if err != nil {
+		return nil, fmt.Errorf("store info: %w", err)
+	}
+	if !s.opts.Godmode { // do not leak filesystem paths
+		info.Location = ""
+	}
+
+	ret := StoreInfo_Output{
+		Info: info,
+	}
+	return &ret, nil
+}
+
 func (s *service) Ping(context.Context, *Ping_Input) (*Ping_Output, error) {
 	return &Ping_Output{Message: "pong"}, nil
 }
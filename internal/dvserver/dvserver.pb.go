@@ -5,6 +5,7 @@ package dvserver
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -73,6 +74,12 @@ type Graph_Input struct {
 	WithoutPRs          bool     `protobuf:"varint,4,opt,name=without_prs,json=withoutPrs,proto3" json:"without_prs,omitempty"`
 	WithoutExternalDeps bool     `protobuf:"varint,5,opt,name=without_external_deps,json=withoutExternalDeps,proto3" json:"without_external_deps,omitempty"`
 	WithFetch           bool     `protobuf:"varint,6,opt,name=with_fetch,json=withFetch,proto3" json:"with_fetch,omitempty"`
+	AsOf                string   `protobuf:"bytes,7,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Limit               int32    `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor              string   `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	DepsDirection       string   `protobuf:"bytes,10,opt,name=deps_direction,json=depsDirection,proto3" json:"deps_direction,omitempty"`
+	DepsDepth           int32    `protobuf:"varint,11,opt,name=deps_depth,json=depsDepth,proto3" json:"deps_depth,omitempty"`
+	Filter              string   `protobuf:"bytes,12,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (m *Graph_Input) Reset()         { *m = Graph_Input{} }
@@ -150,8 +157,53 @@ func (m *Graph_Input) GetWithFetch() bool {
 	return false
 }
 
+func (m *Graph_Input) GetAsOf() string {
+	if m != nil {
+		return m.AsOf
+	}
+	return ""
+}
+
+func (m *Graph_Input) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Graph_Input) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *Graph_Input) GetDepsDirection() string {
+	if m != nil {
+		return m.DepsDirection
+	}
+	return ""
+}
+
+func (m *Graph_Input) GetDepsDepth() int32 {
+	if m != nil {
+		return m.DepsDepth
+	}
+	return 0
+}
+
+func (m *Graph_Input) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 type Graph_Output struct {
-	Tasks []*dvmodel.Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Tasks      []*dvmodel.Task  `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Truncated  bool             `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	NextCursor string           `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Cycles     []*dvmodel.Cycle `protobuf:"bytes,4,rep,name=cycles,proto3" json:"cycles,omitempty"`
 }
 
 func (m *Graph_Output) Reset()         { *m = Graph_Output{} }
@@ -194,6 +246,279 @@ func (m *Graph_Output) GetTasks() []*dvmodel.Task {
 	return nil
 }
 
+func (m *Graph_Output) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *Graph_Output) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *Graph_Output) GetCycles() []*dvmodel.Cycle {
+	if m != nil {
+		return m.Cycles
+	}
+	return nil
+}
+
+type Search struct {
+}
+
+func (m *Search) Reset()         { *m = Search{} }
+func (m *Search) String() string { return proto.CompactTextString(m) }
+func (*Search) ProtoMessage()    {}
+func (*Search) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{1}
+}
+func (m *Search) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Search) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Search.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Search) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Search.Merge(m, src)
+}
+func (m *Search) XXX_Size() int {
+	return m.Size()
+}
+func (m *Search) XXX_DiscardUnknown() {
+	xxx_messageInfo_Search.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Search proto.InternalMessageInfo
+
+type Search_Input struct {
+	Query      string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit      int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	WithClosed bool   `protobuf:"varint,3,opt,name=with_closed,json=withClosed,proto3" json:"with_closed,omitempty"`
+}
+
+func (m *Search_Input) Reset()         { *m = Search_Input{} }
+func (m *Search_Input) String() string { return proto.CompactTextString(m) }
+func (*Search_Input) ProtoMessage()    {}
+func (*Search_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{1, 0}
+}
+func (m *Search_Input) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Search_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Search_Input.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Search_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Search_Input.Merge(m, src)
+}
+func (m *Search_Input) XXX_Size() int {
+	return m.Size()
+}
+func (m *Search_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_Search_Input.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Search_Input proto.InternalMessageInfo
+
+func (m *Search_Input) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *Search_Input) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Search_Input) GetWithClosed() bool {
+	if m != nil {
+		return m.WithClosed
+	}
+	return false
+}
+
+type Search_Highlight struct {
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Fragment string `protobuf:"bytes,2,opt,name=fragment,proto3" json:"fragment,omitempty"`
+}
+
+func (m *Search_Highlight) Reset()         { *m = Search_Highlight{} }
+func (m *Search_Highlight) String() string { return proto.CompactTextString(m) }
+func (*Search_Highlight) ProtoMessage()    {}
+func (*Search_Highlight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{1, 1}
+}
+func (m *Search_Highlight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Search_Highlight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Search_Highlight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Search_Highlight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Search_Highlight.Merge(m, src)
+}
+func (m *Search_Highlight) XXX_Size() int {
+	return m.Size()
+}
+func (m *Search_Highlight) XXX_DiscardUnknown() {
+	xxx_messageInfo_Search_Highlight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Search_Highlight proto.InternalMessageInfo
+
+func (m *Search_Highlight) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *Search_Highlight) GetFragment() string {
+	if m != nil {
+		return m.Fragment
+	}
+	return ""
+}
+
+type Search_Result struct {
+	Task       *dvmodel.Task       `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Score      float64             `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights []*Search_Highlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+}
+
+func (m *Search_Result) Reset()         { *m = Search_Result{} }
+func (m *Search_Result) String() string { return proto.CompactTextString(m) }
+func (*Search_Result) ProtoMessage()    {}
+func (*Search_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{1, 2}
+}
+func (m *Search_Result) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Search_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Search_Result.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Search_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Search_Result.Merge(m, src)
+}
+func (m *Search_Result) XXX_Size() int {
+	return m.Size()
+}
+func (m *Search_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_Search_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Search_Result proto.InternalMessageInfo
+
+func (m *Search_Result) GetTask() *dvmodel.Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *Search_Result) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Search_Result) GetHighlights() []*Search_Highlight {
+	if m != nil {
+		return m.Highlights
+	}
+	return nil
+}
+
+type Search_Output struct {
+	Results []*Search_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *Search_Output) Reset()         { *m = Search_Output{} }
+func (m *Search_Output) String() string { return proto.CompactTextString(m) }
+func (*Search_Output) ProtoMessage()    {}
+func (*Search_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{1, 3}
+}
+func (m *Search_Output) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Search_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Search_Output.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Search_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Search_Output.Merge(m, src)
+}
+func (m *Search_Output) XXX_Size() int {
+	return m.Size()
+}
+func (m *Search_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_Search_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Search_Output proto.InternalMessageInfo
+
+func (m *Search_Output) GetResults() []*Search_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type StoreDump struct {
 }
 
@@ -201,7 +526,7 @@ func (m *StoreDump) Reset()         { *m = StoreDump{} }
 func (m *StoreDump) String() string { return proto.CompactTextString(m) }
 func (*StoreDump) ProtoMessage()    {}
 func (*StoreDump) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{1}
+	return fileDescriptor_af3aef303a4c4cd2, []int{2}
 }
 func (m *StoreDump) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,7 +562,7 @@ func (m *StoreDump_Input) Reset()         { *m = StoreDump_Input{} }
 func (m *StoreDump_Input) String() string { return proto.CompactTextString(m) }
 func (*StoreDump_Input) ProtoMessage()    {}
 func (*StoreDump_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{1, 0}
+	return fileDescriptor_af3aef303a4c4cd2, []int{2, 0}
 }
 func (m *StoreDump_Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +599,7 @@ func (m *StoreDump_Output) Reset()         { *m = StoreDump_Output{} }
 func (m *StoreDump_Output) String() string { return proto.CompactTextString(m) }
 func (*StoreDump_Output) ProtoMessage()    {}
 func (*StoreDump_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{1, 1}
+	return fileDescriptor_af3aef303a4c4cd2, []int{2, 1}
 }
 func (m *StoreDump_Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,21 +635,21 @@ func (m *StoreDump_Output) GetBatch() *dvmodel.Batch {
 	return nil
 }
 
-type Ping struct {
+type StoreInfo struct {
 }
 
-func (m *Ping) Reset()         { *m = Ping{} }
-func (m *Ping) String() string { return proto.CompactTextString(m) }
-func (*Ping) ProtoMessage()    {}
-func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{2}
+func (m *StoreInfo) Reset()         { *m = StoreInfo{} }
+func (m *StoreInfo) String() string { return proto.CompactTextString(m) }
+func (*StoreInfo) ProtoMessage()    {}
+func (*StoreInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{3}
 }
-func (m *Ping) XXX_Unmarshal(b []byte) error {
+func (m *StoreInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Ping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Ping.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StoreInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -334,33 +659,33 @@ func (m *Ping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Ping) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Ping.Merge(m, src)
+func (m *StoreInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo.Merge(m, src)
 }
-func (m *Ping) XXX_Size() int {
+func (m *StoreInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *Ping) XXX_DiscardUnknown() {
-	xxx_messageInfo_Ping.DiscardUnknown(m)
+func (m *StoreInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Ping proto.InternalMessageInfo
+var xxx_messageInfo_StoreInfo proto.InternalMessageInfo
 
-type Ping_Input struct {
+type StoreInfo_Input struct {
 }
 
-func (m *Ping_Input) Reset()         { *m = Ping_Input{} }
-func (m *Ping_Input) String() string { return proto.CompactTextString(m) }
-func (*Ping_Input) ProtoMessage()    {}
-func (*Ping_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{2, 0}
+func (m *StoreInfo_Input) Reset()         { *m = StoreInfo_Input{} }
+func (m *StoreInfo_Input) String() string { return proto.CompactTextString(m) }
+func (*StoreInfo_Input) ProtoMessage()    {}
+func (*StoreInfo_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{3, 0}
 }
-func (m *Ping_Input) XXX_Unmarshal(b []byte) error {
+func (m *StoreInfo_Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Ping_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StoreInfo_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Ping_Input.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StoreInfo_Input.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -370,34 +695,34 @@ func (m *Ping_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Ping_Input) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Ping_Input.Merge(m, src)
+func (m *StoreInfo_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo_Input.Merge(m, src)
 }
-func (m *Ping_Input) XXX_Size() int {
+func (m *StoreInfo_Input) XXX_Size() int {
 	return m.Size()
 }
-func (m *Ping_Input) XXX_DiscardUnknown() {
-	xxx_messageInfo_Ping_Input.DiscardUnknown(m)
+func (m *StoreInfo_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo_Input.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Ping_Input proto.InternalMessageInfo
+var xxx_messageInfo_StoreInfo_Input proto.InternalMessageInfo
 
-type Ping_Output struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+type StoreInfo_Output struct {
+	Info *dvmodel.StoreInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 }
 
-func (m *Ping_Output) Reset()         { *m = Ping_Output{} }
-func (m *Ping_Output) String() string { return proto.CompactTextString(m) }
-func (*Ping_Output) ProtoMessage()    {}
-func (*Ping_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{2, 1}
+func (m *StoreInfo_Output) Reset()         { *m = StoreInfo_Output{} }
+func (m *StoreInfo_Output) String() string { return proto.CompactTextString(m) }
+func (*StoreInfo_Output) ProtoMessage()    {}
+func (*StoreInfo_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{3, 1}
 }
-func (m *Ping_Output) XXX_Unmarshal(b []byte) error {
+func (m *StoreInfo_Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Ping_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StoreInfo_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Ping_Output.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StoreInfo_Output.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -407,33 +732,149 @@ func (m *Ping_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Ping_Output) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Ping_Output.Merge(m, src)
+func (m *StoreInfo_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo_Output.Merge(m, src)
 }
-func (m *Ping_Output) XXX_Size() int {
+func (m *StoreInfo_Output) XXX_Size() int {
 	return m.Size()
 }
-func (m *Ping_Output) XXX_DiscardUnknown() {
-	xxx_messageInfo_Ping_Output.DiscardUnknown(m)
+func (m *StoreInfo_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo_Output.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Ping_Output proto.InternalMessageInfo
+var xxx_messageInfo_StoreInfo_Output proto.InternalMessageInfo
 
-func (m *Ping_Output) GetMessage() string {
+func (m *StoreInfo_Output) GetInfo() *dvmodel.StoreInfo {
 	if m != nil {
-		return m.Message
+		return m.Info
 	}
-	return ""
+	return nil
 }
 
-type Status struct {
+type Ping struct {
+}
+
+func (m *Ping) Reset()         { *m = Ping{} }
+func (m *Ping) String() string { return proto.CompactTextString(m) }
+func (*Ping) ProtoMessage()    {}
+func (*Ping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{4}
+}
+func (m *Ping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ping.Merge(m, src)
+}
+func (m *Ping) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ping) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ping proto.InternalMessageInfo
+
+type Ping_Input struct {
+}
+
+func (m *Ping_Input) Reset()         { *m = Ping_Input{} }
+func (m *Ping_Input) String() string { return proto.CompactTextString(m) }
+func (*Ping_Input) ProtoMessage()    {}
+func (*Ping_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{4, 0}
+}
+func (m *Ping_Input) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ping_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ping_Input.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ping_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ping_Input.Merge(m, src)
+}
+func (m *Ping_Input) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ping_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ping_Input.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ping_Input proto.InternalMessageInfo
+
+type Ping_Output struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *Ping_Output) Reset()         { *m = Ping_Output{} }
+func (m *Ping_Output) String() string { return proto.CompactTextString(m) }
+func (*Ping_Output) ProtoMessage()    {}
+func (*Ping_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3aef303a4c4cd2, []int{4, 1}
+}
+func (m *Ping_Output) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ping_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ping_Output.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ping_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ping_Output.Merge(m, src)
+}
+func (m *Ping_Output) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ping_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ping_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ping_Output proto.InternalMessageInfo
+
+func (m *Ping_Output) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type Status struct {
 }
 
 func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{3}
+	return fileDescriptor_af3aef303a4c4cd2, []int{5}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +910,7 @@ func (m *Status_Input) Reset()         { *m = Status_Input{} }
 func (m *Status_Input) String() string { return proto.CompactTextString(m) }
 func (*Status_Input) ProtoMessage()    {}
 func (*Status_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{3, 0}
+	return fileDescriptor_af3aef303a4c4cd2, []int{5, 0}
 }
 func (m *Status_Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +947,7 @@ func (m *Status_Output) Reset()         { *m = Status_Output{} }
 func (m *Status_Output) String() string { return proto.CompactTextString(m) }
 func (*Status_Output) ProtoMessage()    {}
 func (*Status_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3aef303a4c4cd2, []int{3, 1}
+	return fileDescriptor_af3aef303a4c4cd2, []int{5, 1}
 }
 func (m *Status_Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,9 +987,17 @@ func init() {
 	proto.RegisterType((*Graph)(nil), "depviz.server.Graph")
 	proto.RegisterType((*Graph_Input)(nil), "depviz.server.Graph.Input")
 	proto.RegisterType((*Graph_Output)(nil), "depviz.server.Graph.Output")
+	proto.RegisterType((*Search)(nil), "depviz.server.Search")
+	proto.RegisterType((*Search_Input)(nil), "depviz.server.Search.Input")
+	proto.RegisterType((*Search_Highlight)(nil), "depviz.server.Search.Highlight")
+	proto.RegisterType((*Search_Result)(nil), "depviz.server.Search.Result")
+	proto.RegisterType((*Search_Output)(nil), "depviz.server.Search.Output")
 	proto.RegisterType((*StoreDump)(nil), "depviz.server.StoreDump")
 	proto.RegisterType((*StoreDump_Input)(nil), "depviz.server.StoreDump.Input")
 	proto.RegisterType((*StoreDump_Output)(nil), "depviz.server.StoreDump.Output")
+	proto.RegisterType((*StoreInfo)(nil), "depviz.server.StoreInfo")
+	proto.RegisterType((*StoreInfo_Input)(nil), "depviz.server.StoreInfo.Input")
+	proto.RegisterType((*StoreInfo_Output)(nil), "depviz.server.StoreInfo.Output")
 	proto.RegisterType((*Ping)(nil), "depviz.server.Ping")
 	proto.RegisterType((*Ping_Input)(nil), "depviz.server.Ping.Input")
 	proto.RegisterType((*Ping_Output)(nil), "depviz.server.Ping.Output")
@@ -560,45 +1009,66 @@ func init() {
 func init() { proto.RegisterFile("dvserver.proto", fileDescriptor_af3aef303a4c4cd2) }
 
 var fileDescriptor_af3aef303a4c4cd2 = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcb, 0x6a, 0xdb, 0x4e,
-	0x14, 0xc6, 0x23, 0x27, 0xb2, 0xe3, 0x31, 0x76, 0xc2, 0x84, 0x3f, 0xe8, 0xaf, 0xa4, 0xb2, 0xd1,
-	0xca, 0x59, 0x54, 0x03, 0xce, 0xae, 0x74, 0xe5, 0x26, 0x29, 0xa6, 0x85, 0x04, 0x25, 0x50, 0xda,
-	0x8d, 0x18, 0x5b, 0x13, 0x79, 0xb0, 0xac, 0x11, 0x9a, 0x91, 0x7a, 0x59, 0xf6, 0x09, 0x0a, 0x7d,
-	0x90, 0xbe, 0x46, 0x97, 0x81, 0x6e, 0xba, 0x0a, 0xc5, 0xe9, 0xa2, 0xdb, 0xbe, 0x41, 0x99, 0x8b,
-	0x9d, 0x4b, 0xd3, 0xdd, 0x9c, 0xef, 0xfb, 0xce, 0x6f, 0x66, 0x8e, 0xc7, 0x02, 0x9d, 0xb8, 0xe2,
-	0xa4, 0xa8, 0x48, 0x11, 0xe4, 0x05, 0x13, 0x0c, 0xb6, 0x63, 0x92, 0x57, 0xf4, 0x43, 0xa0, 0x45,
-	0x77, 0x2f, 0x61, 0x2c, 0x49, 0x09, 0xc2, 0x39, 0x45, 0x38, 0xcb, 0x98, 0xc0, 0x82, 0xb2, 0x8c,
-	0xeb, 0xb0, 0xfb, 0x38, 0xa1, 0x62, 0x5a, 0x8e, 0x83, 0x09, 0x9b, 0xa3, 0x84, 0x25, 0x0c, 0x29,
-	0x79, 0x5c, 0x5e, 0xa8, 0x4a, 0x15, 0x6a, 0x65, 0xe2, 0xed, 0xb8, 0x9a, 0xb3, 0x98, 0xa4, 0xba,
-	0xf4, 0xbf, 0xd4, 0x80, 0xfd, 0xbc, 0xc0, 0xf9, 0xd4, 0xfd, 0x6d, 0x01, 0x7b, 0x94, 0xe5, 0xa5,
-	0x80, 0x0e, 0x68, 0x08, 0x5c, 0x24, 0x44, 0x70, 0xc7, 0xea, 0xad, 0xf7, 0x9b, 0xe1, 0xb2, 0x84,
-	0x5d, 0xd0, 0x7a, 0x4b, 0xc5, 0x34, 0x9a, 0xa4, 0x8c, 0x93, 0xd8, 0xa9, 0xf5, 0xac, 0xfe, 0x66,
-	0x08, 0xa4, 0xf4, 0x4c, 0x29, 0x70, 0x1f, 0x6c, 0xcb, 0x8a, 0x95, 0x22, 0xa2, 0x9c, 0xa5, 0x58,
-	0x90, 0xd8, 0x59, 0x57, 0xa9, 0x2d, 0xa3, 0x8f, 0x8c, 0x0c, 0x91, 0x66, 0xc9, 0x68, 0x5e, 0x70,
-	0x67, 0x43, 0xa6, 0x86, 0x9d, 0xc5, 0x55, 0x17, 0xbc, 0xd2, 0xf2, 0x69, 0xc8, 0x35, 0x5b, 0xae,
-	0x0b, 0x0e, 0x07, 0xe0, 0xbf, 0x65, 0x03, 0x79, 0x27, 0x48, 0x91, 0xe1, 0x34, 0x8a, 0x49, 0xce,
-	0x1d, 0x5b, 0x6d, 0xb0, 0x63, 0xcc, 0x23, 0xe3, 0x1d, 0x92, 0x9c, 0xc3, 0x47, 0x40, 0x11, 0xa2,
-	0x0b, 0x22, 0x26, 0x53, 0xa7, 0xae, 0x82, 0x4d, 0xa9, 0x1c, 0x4b, 0xc1, 0x1d, 0x80, 0xfa, 0x49,
-	0x29, 0xe4, 0x9d, 0xfb, 0xc0, 0x16, 0x98, 0xcf, 0xf4, 0x8d, 0x5b, 0x03, 0x18, 0x98, 0x9f, 0x40,
-	0xcf, 0xea, 0x1c, 0xf3, 0x59, 0xa8, 0x03, 0xfe, 0x08, 0x34, 0xcf, 0x04, 0x2b, 0xc8, 0x61, 0x39,
-	0xcf, 0xdd, 0x86, 0x99, 0x99, 0x7b, 0xb0, 0x22, 0xed, 0x03, 0x7b, 0x8c, 0xe5, 0x6e, 0x56, 0xcf,
-	0xea, 0xb7, 0x06, 0x3b, 0x77, 0x49, 0x43, 0x69, 0x85, 0x3a, 0xe1, 0x1f, 0x80, 0x8d, 0x53, 0x9a,
-	0x25, 0x37, 0x14, 0x7f, 0x45, 0x71, 0x40, 0x63, 0x4e, 0x38, 0xc7, 0x09, 0x51, 0x9c, 0x66, 0xb8,
-	0x2c, 0xfd, 0xd7, 0xa0, 0x7e, 0x26, 0xb0, 0x28, 0xf9, 0x4d, 0xdb, 0xf1, 0xaa, 0xed, 0x29, 0xd8,
-	0x26, 0x15, 0x29, 0xde, 0x8b, 0x29, 0xcd, 0x92, 0x88, 0xf2, 0x88, 0xcd, 0x54, 0xff, 0xe6, 0x10,
-	0x2e, 0xae, 0xba, 0x9d, 0xa3, 0x95, 0x37, 0xe2, 0x27, 0x2f, 0xc2, 0x0e, 0xb9, 0x5d, 0xcf, 0x06,
-	0xbf, 0x6a, 0xa0, 0x7d, 0xa8, 0x4e, 0x7b, 0x46, 0x8a, 0x8a, 0x4e, 0x08, 0x3c, 0x35, 0xaf, 0x03,
-	0xba, 0xc1, 0x9d, 0x37, 0x19, 0x28, 0x35, 0xd0, 0xdb, 0xef, 0x3e, 0xe8, 0xe9, 0x13, 0xf9, 0x9d,
-	0x8f, 0xdf, 0x7e, 0x7e, 0xae, 0x6d, 0xc2, 0x3a, 0x4a, 0x14, 0x08, 0xdf, 0x1a, 0x1f, 0xf4, 0xee,
-	0x75, 0xae, 0x1c, 0x43, 0xee, 0xfe, 0xd3, 0x37, 0xf4, 0x1d, 0x45, 0x6f, 0xc3, 0x16, 0xe2, 0xd2,
-	0x42, 0xb1, 0xa4, 0xbe, 0xd4, 0x63, 0x85, 0xff, 0xdf, 0xeb, 0x96, 0xa2, 0x01, 0xbb, 0x0f, 0x59,
-	0x86, 0xd9, 0x56, 0xcc, 0x06, 0xb4, 0x51, 0x2e, 0x29, 0xe7, 0xcb, 0x79, 0xc3, 0xdd, 0xbf, 0x4e,
-	0x23, 0x65, 0x43, 0xdc, 0x7b, 0xd8, 0x34, 0xcc, 0x2d, 0xc5, 0x6c, 0xc2, 0x06, 0xe2, 0x4a, 0x1f,
-	0x3e, 0xf9, 0xba, 0xf0, 0xac, 0xcb, 0x85, 0x67, 0xfd, 0x58, 0x78, 0xd6, 0xa7, 0x6b, 0x6f, 0xed,
-	0xf2, 0xda, 0x5b, 0xfb, 0x7e, 0xed, 0xad, 0xbd, 0xe9, 0xcd, 0x59, 0x99, 0x06, 0x94, 0x21, 0xcd,
-	0x43, 0x34, 0xd3, 0xef, 0x19, 0x2d, 0x3f, 0x12, 0xe3, 0xba, 0xfa, 0xeb, 0x1e, 0xfc, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x22, 0x51, 0x32, 0xf9, 0x37, 0x04, 0x00, 0x00,
+	// 931 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x26, 0x4e, 0xe2, 0x17, 0x92, 0x5d, 0x4d, 0x17, 0x30, 0xde, 0x92, 0x44, 0x91,
+	0x40, 0x59, 0xad, 0x88, 0xa5, 0x56, 0x70, 0x40, 0x20, 0x50, 0xdb, 0x5d, 0x88, 0x16, 0xa9, 0x95,
+	0x5b, 0x09, 0xc1, 0x25, 0x72, 0xed, 0x89, 0x3d, 0xaa, 0xe3, 0x31, 0x33, 0xe3, 0xb0, 0xe5, 0xc8,
+	0x85, 0x2b, 0x82, 0x3b, 0x12, 0x1f, 0x84, 0x3b, 0xc7, 0x95, 0xb8, 0x70, 0x5a, 0xa1, 0x94, 0x0b,
+	0xdf, 0x02, 0xcd, 0x8c, 0xed, 0xa4, 0xd9, 0x74, 0x6f, 0x7e, 0xff, 0xf7, 0xe6, 0xf7, 0xc6, 0xef,
+	0xff, 0x12, 0x43, 0x37, 0x5c, 0x70, 0xcc, 0x16, 0x98, 0x8d, 0x33, 0x46, 0x05, 0x45, 0x9d, 0x10,
+	0x67, 0x0b, 0xf2, 0xc3, 0x58, 0x8b, 0xce, 0x7e, 0x44, 0x69, 0x94, 0x60, 0xd7, 0xcf, 0x88, 0xeb,
+	0xa7, 0x29, 0x15, 0xbe, 0x20, 0x34, 0xe5, 0xba, 0xd8, 0xf9, 0x20, 0x22, 0x22, 0xce, 0x2f, 0xc7,
+	0x01, 0x9d, 0xbb, 0x11, 0x8d, 0xa8, 0xab, 0xe4, 0xcb, 0x7c, 0xa6, 0x22, 0x15, 0xa8, 0xa7, 0xa2,
+	0xbc, 0x13, 0x2e, 0xe6, 0x34, 0xc4, 0x89, 0x0e, 0x87, 0x7f, 0xd4, 0xc1, 0xfc, 0x82, 0xf9, 0x59,
+	0xec, 0xfc, 0x52, 0x03, 0x73, 0x92, 0x66, 0xb9, 0x40, 0x36, 0x34, 0x85, 0xcf, 0x22, 0x2c, 0xb8,
+	0x6d, 0x0c, 0x6a, 0x23, 0xcb, 0x2b, 0x43, 0xd4, 0x87, 0xf6, 0xf7, 0x44, 0xc4, 0xd3, 0x20, 0xa1,
+	0x1c, 0x87, 0xf6, 0xee, 0xc0, 0x18, 0xb5, 0x3c, 0x90, 0xd2, 0xb1, 0x52, 0xd0, 0x23, 0xb8, 0x2f,
+	0x23, 0x9a, 0x8b, 0x29, 0xe1, 0x34, 0xf1, 0x05, 0x0e, 0xed, 0x9a, 0xaa, 0xba, 0x57, 0xe8, 0x93,
+	0x42, 0x46, 0x2e, 0xb4, 0xcb, 0xd2, 0x8c, 0x71, 0xbb, 0x2e, 0xab, 0x8e, 0xba, 0xcb, 0x97, 0x7d,
+	0xf8, 0x5a, 0xcb, 0x67, 0x1e, 0xd7, 0x6c, 0xf9, 0xcc, 0x38, 0x3a, 0x80, 0x37, 0xcb, 0x03, 0xf8,
+	0xb9, 0xc0, 0x2c, 0xf5, 0x93, 0x69, 0x88, 0x33, 0x6e, 0x9b, 0xaa, 0xc1, 0x5e, 0x91, 0x7c, 0x52,
+	0xe4, 0x4e, 0x70, 0xc6, 0xd1, 0xbb, 0xa0, 0x08, 0xd3, 0x19, 0x16, 0x41, 0x6c, 0x37, 0x54, 0xa1,
+	0x25, 0x95, 0xa7, 0x52, 0x40, 0x7b, 0x60, 0xfa, 0x7c, 0x4a, 0x67, 0x76, 0x73, 0x60, 0x8c, 0x2c,
+	0xaf, 0xee, 0xf3, 0xd3, 0x19, 0x7a, 0x00, 0x66, 0x42, 0xe6, 0x44, 0xd8, 0xad, 0x81, 0x31, 0x32,
+	0x3d, 0x1d, 0xa0, 0xb7, 0xa0, 0x11, 0xe4, 0x8c, 0x53, 0x66, 0x5b, 0xaa, 0xb6, 0x88, 0xd0, 0x7b,
+	0xd0, 0x95, 0x97, 0x98, 0x86, 0x84, 0xe1, 0x40, 0xfa, 0x62, 0x83, 0xca, 0x4b, 0x0f, 0xf9, 0x49,
+	0x29, 0xca, 0x8b, 0xe8, 0x32, 0x9c, 0x89, 0xd8, 0x6e, 0x2b, 0xb2, 0xa5, 0x4a, 0xa4, 0x20, 0xe9,
+	0x33, 0x92, 0x08, 0xcc, 0xec, 0x37, 0x34, 0x5d, 0x47, 0xce, 0x6f, 0x06, 0x34, 0x4e, 0x73, 0x21,
+	0x5d, 0x19, 0x81, 0x29, 0x7c, 0x7e, 0xa5, 0x3d, 0x69, 0x1f, 0xa0, 0x71, 0xb1, 0x24, 0xda, 0xcd,
+	0x0b, 0x9f, 0x5f, 0x79, 0xba, 0x00, 0xed, 0x83, 0x25, 0x58, 0x9e, 0x06, 0xbe, 0xa8, 0x3c, 0x5a,
+	0x09, 0xd2, 0xc3, 0x14, 0x3f, 0x17, 0xd3, 0xe2, 0x6d, 0x6a, 0xaa, 0x1f, 0x48, 0xe9, 0x58, 0xbf,
+	0xd1, 0x63, 0x68, 0x04, 0xd7, 0x41, 0x82, 0xa5, 0x27, 0xb2, 0xd3, 0xde, 0xed, 0x4e, 0xc7, 0x32,
+	0xe7, 0x15, 0x25, 0xc3, 0xff, 0x76, 0xa1, 0x71, 0x8e, 0x7d, 0x16, 0xc4, 0xce, 0x45, 0xb9, 0x3f,
+	0x0f, 0xc0, 0xfc, 0x2e, 0xc7, 0xec, 0xda, 0x36, 0x14, 0x5b, 0x07, 0xab, 0xb1, 0xee, 0xae, 0x8f,
+	0x75, 0x63, 0xa3, 0x6a, 0x9b, 0x1b, 0xe5, 0x7c, 0x0a, 0xd6, 0x97, 0x24, 0x8a, 0x13, 0x12, 0xc5,
+	0x8a, 0x3c, 0x23, 0x38, 0x09, 0x4b, 0xb2, 0x0a, 0x90, 0x03, 0xad, 0x19, 0xf3, 0xa3, 0x39, 0x4e,
+	0x35, 0xdc, 0xf2, 0xaa, 0xd8, 0xf9, 0xc9, 0x80, 0x86, 0x87, 0x79, 0x9e, 0x08, 0xf4, 0x3e, 0xd4,
+	0xe5, 0x7c, 0xd4, 0xd9, 0xed, 0xf3, 0x53, 0x79, 0xd9, 0x84, 0x07, 0x94, 0x61, 0xc5, 0x32, 0x3c,
+	0x1d, 0xa0, 0xcf, 0x00, 0xe2, 0xf2, 0x1e, 0xdc, 0xae, 0xa9, 0xc9, 0xf4, 0xc7, 0xb7, 0x7e, 0xa8,
+	0x63, 0x3d, 0x88, 0x71, 0x75, 0x5f, 0x6f, 0xed, 0x88, 0xf3, 0x79, 0xe5, 0xe4, 0x47, 0xd0, 0x64,
+	0xea, 0x4a, 0xa5, 0x97, 0xfb, 0xdb, 0x39, 0xfa, 0xde, 0x5e, 0x59, 0x3c, 0x9c, 0x80, 0x75, 0x2e,
+	0x28, 0xc3, 0x27, 0xf9, 0x3c, 0x73, 0x9a, 0xc5, 0xb4, 0x9d, 0xc3, 0x8a, 0xfb, 0x08, 0xcc, 0x4b,
+	0x5f, 0xee, 0xb9, 0x7e, 0xc3, 0x0d, 0xdf, 0x8e, 0x64, 0xca, 0xd3, 0x15, 0xc3, 0x67, 0x05, 0x6a,
+	0x92, 0xce, 0xe8, 0x0a, 0xf5, 0x61, 0x85, 0x7a, 0x0c, 0x75, 0x92, 0xce, 0x68, 0x41, 0x7a, 0xfb,
+	0x36, 0xa9, 0x3a, 0xe9, 0xa9, 0xa2, 0xe1, 0x21, 0xd4, 0xcf, 0x48, 0x1a, 0xad, 0x38, 0xc3, 0x8a,
+	0x63, 0x43, 0x73, 0x8e, 0x39, 0xf7, 0x23, 0x5c, 0x58, 0x56, 0x86, 0xc3, 0x6f, 0xa0, 0x71, 0x2e,
+	0x7c, 0x91, 0xf3, 0xd5, 0xb1, 0xa7, 0xd5, 0xb1, 0x4f, 0xe0, 0x3e, 0x5e, 0x60, 0x76, 0x2d, 0x62,
+	0x92, 0x46, 0x53, 0xc2, 0xa7, 0x54, 0xdb, 0xd6, 0x3a, 0x42, 0xcb, 0x97, 0xfd, 0xee, 0x93, 0x2a,
+	0x37, 0xe1, 0xa7, 0xcf, 0xbc, 0x2e, 0x5e, 0x8f, 0xaf, 0x0e, 0x7e, 0xaf, 0x43, 0xe7, 0x44, 0x5d,
+	0xf8, 0x1c, 0xb3, 0x05, 0x09, 0x30, 0x3a, 0x2b, 0xfe, 0xe4, 0x90, 0xb3, 0x31, 0x69, 0xa5, 0x8e,
+	0x75, 0xfb, 0x87, 0x5b, 0x73, 0xfa, 0x46, 0xc3, 0xee, 0x8f, 0x7f, 0xfd, 0xfb, 0xeb, 0x6e, 0x0b,
+	0x35, 0xdc, 0x48, 0x81, 0x2e, 0xca, 0xb5, 0x47, 0x0f, 0xb7, 0x9b, 0xa7, 0x99, 0x77, 0x38, 0x5b,
+	0x40, 0xef, 0x29, 0xa8, 0x85, 0x9a, 0x2e, 0xd7, 0x2c, 0x7f, 0xcd, 0x61, 0xd4, 0xdb, 0x3c, 0x5b,
+	0x66, 0x0a, 0x76, 0xff, 0xce, 0x7c, 0x81, 0xdf, 0x53, 0xf8, 0x0e, 0x6a, 0xbb, 0x5c, 0xa6, 0xdc,
+	0x50, 0x52, 0xfd, 0x35, 0xe7, 0xb7, 0xb7, 0x90, 0x99, 0xd7, 0xb5, 0x50, 0xf9, 0x3b, 0x5a, 0xc8,
+	0x7d, 0x40, 0x5f, 0xe9, 0x7d, 0x40, 0xef, 0x6c, 0x9c, 0x96, 0x62, 0x01, 0x76, 0xb6, 0xa5, 0x0a,
+	0x66, 0x47, 0x31, 0x9b, 0xc8, 0x74, 0x33, 0x49, 0xb9, 0x28, 0x17, 0xe5, 0xd5, 0x49, 0x2b, 0xf9,
+	0xae, 0x49, 0xeb, 0xe4, 0xab, 0x93, 0x56, 0xfa, 0xd1, 0xc7, 0x7f, 0x2e, 0x7b, 0xc6, 0x8b, 0x65,
+	0xcf, 0xf8, 0x67, 0xd9, 0x33, 0x7e, 0xbe, 0xe9, 0xed, 0xbc, 0xb8, 0xe9, 0xed, 0xfc, 0x7d, 0xd3,
+	0xdb, 0xf9, 0x76, 0x30, 0xa7, 0x79, 0x32, 0x26, 0xd4, 0xd5, 0x3c, 0x97, 0xa4, 0xfa, 0x7b, 0xe2,
+	0x96, 0x1f, 0xe9, 0xcb, 0x86, 0xfa, 0x74, 0x1e, 0xfe, 0x3f, 0x00, 0x85, 0x4d, 0xf7, 0x0d, 0xb7,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -614,7 +1084,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type DepvizServiceClient interface {
 	Graph(ctx context.Context, in *Graph_Input, opts ...grpc.CallOption) (*Graph_Output, error)
+	Search(ctx context.Context, in *Search_Input, opts ...grpc.CallOption) (*Search_Output, error)
 	StoreDump(ctx context.Context, in *StoreDump_Input, opts ...grpc.CallOption) (*StoreDump_Output, error)
+	StoreInfo(ctx context.Context, in *StoreInfo_Input, opts ...grpc.CallOption) (*StoreInfo_Output, error)
 	Ping(ctx context.Context, in *Ping_Input, opts ...grpc.CallOption) (*Ping_Output, error)
 	Status(ctx context.Context, in *Status_Input, opts ...grpc.CallOption) (*Status_Output, error)
 }
@@ -636,6 +1108,15 @@ func (c *depvizServiceClient) Graph(ctx context.Context, in *Graph_Input, opts .
 	return out, nil
 }
 
+func (c *depvizServiceClient) Search(ctx context.Context, in *Search_Input, opts ...grpc.CallOption) (*Search_Output, error) {
+	out := new(Search_Output)
+	err := c.cc.Invoke(ctx, "/depviz.server.DepvizService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *depvizServiceClient) StoreDump(ctx context.Context, in *StoreDump_Input, opts ...grpc.CallOption) (*StoreDump_Output, error) {
 	out := new(StoreDump_Output)
 	err := c.cc.Invoke(ctx, "/depviz.server.DepvizService/StoreDump", in, out, opts...)
@@ -645,6 +1126,15 @@ func (c *depvizServiceClient) StoreDump(ctx context.Context, in *StoreDump_Input
 	return out, nil
 }
 
+func (c *depvizServiceClient) StoreInfo(ctx context.Context, in *StoreInfo_Input, opts ...grpc.CallOption) (*StoreInfo_Output, error) {
+	out := new(StoreInfo_Output)
+	err := c.cc.Invoke(ctx, "/depviz.server.DepvizService/StoreInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *depvizServiceClient) Ping(ctx context.Context, in *Ping_Input, opts ...grpc.CallOption) (*Ping_Output, error) {
 	out := new(Ping_Output)
 	err := c.cc.Invoke(ctx, "/depviz.server.DepvizService/Ping", in, out, opts...)
@@ -666,7 +1156,9 @@ func (c *depvizServiceClient) Status(ctx context.Context, in *Status_Input, opts
 // DepvizServiceServer is the server API for DepvizService service.
 type DepvizServiceServer interface {
 	Graph(context.Context, *Graph_Input) (*Graph_Output, error)
+	Search(context.Context, *Search_Input) (*Search_Output, error)
 	StoreDump(context.Context, *StoreDump_Input) (*StoreDump_Output, error)
+	StoreInfo(context.Context, *StoreInfo_Input) (*StoreInfo_Output, error)
 	Ping(context.Context, *Ping_Input) (*Ping_Output, error)
 	Status(context.Context, *Status_Input) (*Status_Output, error)
 }
@@ -678,9 +1170,15 @@ type UnimplementedDepvizServiceServer struct {
 func (*UnimplementedDepvizServiceServer) Graph(ctx context.Context, req *Graph_Input) (*Graph_Output, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Graph not implemented")
 }
+func (*UnimplementedDepvizServiceServer) Search(ctx context.Context, req *Search_Input) (*Search_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
 func (*UnimplementedDepvizServiceServer) StoreDump(ctx context.Context, req *StoreDump_Input) (*StoreDump_Output, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreDump not implemented")
 }
+func (*UnimplementedDepvizServiceServer) StoreInfo(ctx context.Context, req *StoreInfo_Input) (*StoreInfo_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreInfo not implemented")
+}
 func (*UnimplementedDepvizServiceServer) Ping(ctx context.Context, req *Ping_Input) (*Ping_Output, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
@@ -710,6 +1208,24 @@ func _DepvizService_Graph_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepvizService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Search_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepvizServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/depviz.server.DepvizService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepvizServiceServer).Search(ctx, req.(*Search_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DepvizService_StoreDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StoreDump_Input)
 	if err := dec(in); err != nil {
@@ -728,6 +1244,24 @@ func _DepvizService_StoreDump_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepvizService_StoreInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreInfo_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepvizServiceServer).StoreInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/depviz.server.DepvizService/StoreInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepvizServiceServer).StoreInfo(ctx, req.(*StoreInfo_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DepvizService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Ping_Input)
 	if err := dec(in); err != nil {
@@ -772,10 +1306,18 @@ var _DepvizService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Graph",
 			Handler:    _DepvizService_Graph_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _DepvizService_Search_Handler,
+		},
 		{
 			MethodName: "StoreDump",
 			Handler:    _DepvizService_StoreDump_Handler,
 		},
+		{
+			MethodName: "StoreInfo",
+			Handler:    _DepvizService_StoreInfo_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _DepvizService_Ping_Handler,
@@ -832,6 +1374,44 @@ func (m *Graph_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DepsDepth != 0 {
+		i = encodeVarintDvserver(dAtA, i, uint64(m.DepsDepth))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DepsDirection) > 0 {
+		i -= len(m.DepsDirection)
+		copy(dAtA[i:], m.DepsDirection)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.DepsDirection)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDvserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AsOf) > 0 {
+		i -= len(m.AsOf)
+		copy(dAtA[i:], m.AsOf)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.AsOf)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.WithFetch {
 		i--
 		if m.WithFetch {
@@ -914,6 +1494,37 @@ func (m *Graph_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Cycles) > 0 {
+		for iNdEx := len(m.Cycles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cycles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDvserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Tasks) > 0 {
 		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -931,7 +1542,7 @@ func (m *Graph_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StoreDump) Marshal() (dAtA []byte, err error) {
+func (m *Search) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -941,12 +1552,12 @@ func (m *StoreDump) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreDump) MarshalTo(dAtA []byte) (int, error) {
+func (m *Search) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StoreDump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Search) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -954,7 +1565,7 @@ func (m *StoreDump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StoreDump_Input) Marshal() (dAtA []byte, err error) {
+func (m *Search_Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -964,20 +1575,42 @@ func (m *StoreDump_Input) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreDump_Input) MarshalTo(dAtA []byte) (int, error) {
+func (m *Search_Input) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StoreDump_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Search_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.WithClosed {
+		i--
+		if m.WithClosed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDvserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StoreDump_Output) Marshal() (dAtA []byte, err error) {
+func (m *Search_Highlight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -987,32 +1620,34 @@ func (m *StoreDump_Output) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreDump_Output) MarshalTo(dAtA []byte) (int, error) {
+func (m *Search_Highlight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StoreDump_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Search_Highlight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Batch != nil {
-		{
-			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDvserver(dAtA, i, uint64(size))
-		}
+	if len(m.Fragment) > 0 {
+		i -= len(m.Fragment)
+		copy(dAtA[i:], m.Fragment)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.Fragment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDvserver(dAtA, i, uint64(len(m.Field)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Ping) Marshal() (dAtA []byte, err error) {
+func (m *Search_Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1022,20 +1657,52 @@ func (m *Ping) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Ping) MarshalTo(dAtA []byte) (int, error) {
+func (m *Search_Result) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Search_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Highlights) > 0 {
+		for iNdEx := len(m.Highlights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Highlights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDvserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDvserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Ping_Input) Marshal() (dAtA []byte, err error) {
+func (m *Search_Output) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1045,12 +1712,234 @@ func (m *Ping_Input) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Ping_Input) MarshalTo(dAtA []byte) (int, error) {
+func (m *Search_Output) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Ping_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Search_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDvserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreDump) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreDump) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreDump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreDump_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreDump_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreDump_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreDump_Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreDump_Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreDump_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDvserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreInfo_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreInfo_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreInfo_Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreInfo_Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDvserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Ping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Ping_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ping_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ping_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1214,6 +2103,28 @@ func (m *Graph_Input) Size() (n int) {
 	if m.WithFetch {
 		n += 2
 	}
+	l = len(m.AsOf)
+	if l > 0 {
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDvserver(uint64(m.Limit))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	l = len(m.DepsDirection)
+	if l > 0 {
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	if m.DepsDepth != 0 {
+		n += 1 + sovDvserver(uint64(m.DepsDepth))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovDvserver(uint64(l))
+	}
 	return n
 }
 
@@ -1229,10 +2140,23 @@ func (m *Graph_Output) Size() (n int) {
 			n += 1 + l + sovDvserver(uint64(l))
 		}
 	}
+	if m.Truncated {
+		n += 2
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	if len(m.Cycles) > 0 {
+		for _, e := range m.Cycles {
+			l = e.Size()
+			n += 1 + l + sovDvserver(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *StoreDump) Size() (n int) {
+func (m *Search) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1241,38 +2165,151 @@ func (m *StoreDump) Size() (n int) {
 	return n
 }
 
-func (m *StoreDump_Input) Size() (n int) {
+func (m *Search_Input) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDvserver(uint64(m.Limit))
+	}
+	if m.WithClosed {
+		n += 2
+	}
 	return n
 }
 
-func (m *StoreDump_Output) Size() (n int) {
+func (m *Search_Highlight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Batch != nil {
-		l = m.Batch.Size()
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	l = len(m.Fragment)
+	if l > 0 {
 		n += 1 + l + sovDvserver(uint64(l))
 	}
 	return n
 }
 
-func (m *Ping) Size() (n int) {
+func (m *Search_Result) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if len(m.Highlights) > 0 {
+		for _, e := range m.Highlights {
+			l = e.Size()
+			n += 1 + l + sovDvserver(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Ping_Input) Size() (n int) {
+func (m *Search_Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovDvserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreDump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StoreDump_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StoreDump_Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	return n
+}
+
+func (m *StoreInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StoreInfo_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StoreInfo_Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovDvserver(uint64(l))
+	}
+	return n
+}
+
+func (m *Ping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Ping_Input) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1340,25 +2377,959 @@ func (m *Graph) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowDvserver
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Graph: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Graph: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Graph_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithClosed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithClosed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutIsolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithoutIsolated = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutPRs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithoutPRs = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutExternalDeps", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithoutExternalDeps = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithFetch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithFetch = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsOf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepsDirection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepsDirection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepsDepth", wireType)
+			}
+			m.DepsDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepsDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Graph_Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &dvmodel.Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cycles = append(m.Cycles, &dvmodel.Cycle{})
+			if err := m.Cycles[len(m.Cycles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Search) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Search: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Search: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Search_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithClosed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithClosed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Search_Highlight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Highlight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Highlight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fragment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Search_Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &dvmodel.Task{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Highlights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDvserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDvserver
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Highlights = append(m.Highlights, &Search_Highlight{})
+			if err := m.Highlights[len(m.Highlights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Graph: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Graph: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDvserver(dAtA[iNdEx:])
@@ -1380,7 +3351,7 @@ func (m *Graph) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Graph_Input) Unmarshal(dAtA []byte) error {
+func (m *Search_Output) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,17 +3374,17 @@ func (m *Graph_Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDvserver
@@ -1423,124 +3394,126 @@ func (m *Graph_Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDvserver
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDvserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			m.Results = append(m.Results, &Search_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithClosed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDvserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
 			}
-			m.WithClosed = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithoutIsolated", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDvserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreDump) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
 			}
-			m.WithoutIsolated = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithoutPRs", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDvserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.WithoutPRs = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithoutExternalDeps", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreDump: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreDump: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDvserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDvserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDvserver
 			}
-			m.WithoutExternalDeps = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithFetch", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDvserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreDump_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDvserver
 			}
-			m.WithFetch = bool(v != 0)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDvserver(dAtA[iNdEx:])
@@ -1562,7 +3535,7 @@ func (m *Graph_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Graph_Output) Unmarshal(dAtA []byte) error {
+func (m *StoreDump_Output) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,7 +3566,7 @@ func (m *Graph_Output) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1620,8 +3593,10 @@ func (m *Graph_Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &dvmodel.Task{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Batch == nil {
+				m.Batch = &dvmodel.Batch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1646,7 +3621,7 @@ func (m *Graph_Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreDump) Unmarshal(dAtA []byte) error {
+func (m *StoreInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,10 +3644,10 @@ func (m *StoreDump) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreDump: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreDump: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1696,7 +3671,7 @@ func (m *StoreDump) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreDump_Input) Unmarshal(dAtA []byte) error {
+func (m *StoreInfo_Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1746,7 +3721,7 @@ func (m *StoreDump_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreDump_Output) Unmarshal(dAtA []byte) error {
+func (m *StoreInfo_Output) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1777,7 +3752,7 @@ func (m *StoreDump_Output) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1804,10 +3779,10 @@ func (m *StoreDump_Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Batch == nil {
-				m.Batch = &dvmodel.Batch{}
+			if m.Info == nil {
+				m.Info = &dvmodel.StoreInfo{}
 			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"moul.io/depviz/v3/internal/dvcore"
+	"moul.io/depviz/v3/internal/dvstore"
 	"moul.io/depviz/v3/pkg/chiutil"
 	"moul.io/multipmuri"
 )
@@ -55,6 +56,13 @@ type Opts struct {
 	AutoUpdateInterval time.Duration
 	GitHubClientID     string
 	GitHubClientSecret string
+	StoreBackend       string
+	StoreLocation      string
+	Snapshots          dvstore.SnapshotPolicy
+	PruneInterval      time.Duration
+	PruneOpts          dvstore.PruneOpts
+	// WithIndex keeps the tasks and their relationships in memory, for faster Graph requests on large stores.
+	WithIndex bool
 }
 
 type Service interface {
@@ -75,6 +83,7 @@ type service struct {
 	grpcListenerAddr string
 	httpListenerAddr string
 	cache            *cache.Cache
+	index            *dvstore.Index
 }
 
 var _ DepvizServiceServer = (*service)(nil)
@@ -98,6 +107,9 @@ func New(ctx context.Context, h *cayley.Handle, schema *schema.Config, opts Opts
 	if opts.AutoUpdateInterval == 0 {
 		opts.AutoUpdateInterval = defaultAutoUpdateInterval
 	}
+	if opts.PruneInterval > 0 && opts.PruneOpts.UnconfiguredTargets && len(opts.PruneOpts.Targets) == 0 {
+		return nil, fmt.Errorf("pruning unconfigured targets requires at least one target")
+	}
 
 	svc := service{
 		ctx:    ctx,
@@ -106,6 +118,16 @@ func New(ctx context.Context, h *cayley.Handle, schema *schema.Config, opts Opts
 		opts:   opts,
 	}
 
+	if opts.WithIndex {
+		start := time.Now()
+		index, err := dvstore.NewIndex(ctx, h, schema)
+		if err != nil {
+			return nil, fmt.Errorf("build index: %w", err)
+		}
+		svc.index = index
+		opts.Logger.Info("index built", zap.Int("tasks", index.Len()), zap.Duration("duration", time.Since(start)))
+	}
+
 	var (
 		grpcLogger = opts.Logger.Named("gRPC")
 		httpLogger = opts.Logger.Named("HTTP")
@@ -268,6 +290,23 @@ func New(ctx context.Context, h *cayley.Handle, schema *schema.Config, opts Opts
 		})
 	}
 
+	if opts.PruneInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		svc.workers.Add(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(opts.PruneInterval):
+					svc.prune()
+				}
+			}
+		}, func(error) {
+			cancel()
+		})
+	}
+
 	// FIXME: add grpc-web support?
 
 	return &svc, nil
@@ -275,7 +314,7 @@ func New(ctx context.Context, h *cayley.Handle, schema *schema.Config, opts Opts
 
 func (s *service) autoUpdate(targets []multipmuri.Entity) {
 	s.opts.Logger.Debug("pull and save", zap.Any("targets", targets))
-	changed, err := dvcore.PullAndSave(targets, s.h, s.schema, s.opts.GitHubToken, false, s.opts.Logger)
+	changed, err := dvcore.PullAndSave(targets, s.h, s.schema, s.opts.GitHubToken, false, s.opts.Snapshots, s.index, s.opts.Logger)
 	if err != nil {
 		s.opts.Logger.Warn("pull and save", zap.Error(err))
 	}
@@ -284,6 +323,23 @@ func (s *service) autoUpdate(targets []multipmuri.Entity) {
 	}
 }
 
+func (s *service) prune() {
+	report, err := dvstore.Prune(s.ctx, s.h, s.opts.PruneOpts)
+	if err != nil {
+		s.opts.Logger.Warn("prune store", zap.Error(err))
+		return
+	}
+	s.opts.Logger.Debug("prune store", zap.Int("entities", report.Entities()), zap.Int("quads", report.Quads))
+	if report.Quads > 0 && s.index != nil {
+		if err := s.index.Reload(s.ctx, s.h, s.schema); err != nil {
+			s.opts.Logger.Warn("reload index", zap.Error(err))
+		}
+	}
+	if report.Quads > 0 && s.cache != nil {
+		s.cache.Flush()
+	}
+}
+
 func (s *service) Run() error {
 	return s.workers.Run()
 }
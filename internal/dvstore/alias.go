@@ -0,0 +1,359 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvparser"
+	"moul.io/multipmuri"
+)
+
+// SameAsPredicate links an alias to its canonical entity, e.g. the GitLab account of a GitHub user,
+// or the previous IRI of a transferred issue.
+const SameAsPredicate = quad.IRI("owl:sameAs")
+
+// Aliases maps the aliases of the store to their canonical entity.
+//
+// Aliases are kept flat: a canonical entity is never an alias itself, see AddAlias.
+type Aliases map[quad.IRI]quad.IRI
+
+// LoadAliases returns the aliases declared in the store.
+func LoadAliases(ctx context.Context, h *cayley.Handle) (Aliases, error) {
+	aliases := Aliases{}
+	p := path.StartPath(h).Tag("alias").Out(SameAsPredicate).Tag("canonical")
+	err := p.Iterate(ctx).TagValues(h, func(tags map[string]quad.Value) {
+		alias, _ := tags["alias"].(quad.IRI)
+		canonical, _ := tags["canonical"].(quad.IRI)
+		if alias != "" && canonical != "" && alias != canonical {
+			aliases[alias] = canonical
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load aliases: %w", err)
+	}
+	return aliases, nil
+}
+
+// Canonical returns the canonical entity of iri, which is iri itself if it is not an alias.
+func (a Aliases) Canonical(iri quad.IRI) quad.IRI {
+	for i := 0; i <= len(a); i++ { // bounded, in case a store was edited by hand into a cycle
+		canonical, found := a[iri]
+		if !found {
+			break
+		}
+		iri = canonical
+	}
+	return iri
+}
+
+// Group returns the canonical entity of iri and all its aliases, sorted.
+func (a Aliases) Group(iri quad.IRI) []quad.IRI {
+	canonical := a.Canonical(iri)
+	group := []quad.IRI{canonical}
+	for alias := range a {
+		if alias != canonical && a.Canonical(alias) == canonical {
+			group = append(group, alias)
+		}
+	}
+	sort.Slice(group, func(i, j int) bool { return group[i] < group[j] })
+	return group
+}
+
+// AddAlias declares alias as another IRI of canonical.
+//
+// If canonical is an alias, its own canonical entity is used, and the aliases of alias are moved to canonical.
+func AddAlias(ctx context.Context, h *cayley.Handle, canonical, alias quad.IRI) error {
+	aliases, err := LoadAliases(ctx, h)
+	if err != nil {
+		return err
+	}
+	canonical = aliases.Canonical(canonical)
+	if canonical == alias {
+		return fmt.Errorf("%s cannot be an alias of itself", alias)
+	}
+
+	tx := cayley.NewTransaction()
+	if previous, found := aliases[alias]; found {
+		if previous == canonical {
+			return nil
+		}
+		tx.RemoveQuad(quad.Make(alias, SameAsPredicate, previous, nil))
+	}
+	tx.AddQuad(quad.Make(alias, SameAsPredicate, canonical, nil))
+	for other, target := range aliases {
+		if target == alias {
+			tx.RemoveQuad(quad.Make(other, SameAsPredicate, alias, nil))
+			tx.AddQuad(quad.Make(other, SameAsPredicate, canonical, nil))
+		}
+	}
+	if err := applyTransaction(ctx, h, tx); err != nil {
+		return fmt.Errorf("apply tx: %w", err)
+	}
+	return nil
+}
+
+// RemoveAlias removes alias, which becomes a distinct entity again.
+func RemoveAlias(ctx context.Context, h *cayley.Handle, alias quad.IRI) error {
+	aliases, err := LoadAliases(ctx, h)
+	if err != nil {
+		return err
+	}
+	canonical, found := aliases[alias]
+	if !found {
+		return fmt.Errorf("%s is not an alias", alias)
+	}
+	tx := cayley.NewTransaction()
+	tx.RemoveQuad(quad.Make(alias, SameAsPredicate, canonical, nil))
+	if err := applyTransaction(ctx, h, tx); err != nil {
+		return fmt.Errorf("apply tx: %w", err)
+	}
+	return nil
+}
+
+// AliasSuggestion is a pair of entities that are probably the same, see SuggestAliases.
+type AliasSuggestion struct {
+	Canonical quad.IRI `json:"canonical"`
+	Alias     quad.IRI `json:"alias"`
+	Reason    string   `json:"reason"`
+}
+
+// SuggestAliases returns the entities that look like the same one and are not aliases yet:
+//
+//   - users with the same email, or the same login on different providers,
+//   - tasks with the same title, author and creation time, e.g. a transferred issue.
+//
+// The canonical entity is the oldest user, and the most recently updated task.
+func SuggestAliases(ctx context.Context, h *cayley.Handle, schema *schema.Config) ([]AliasSuggestion, error) {
+	aliases, err := LoadAliases(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := []AliasSuggestion{}
+	suggested := map[quad.IRI]bool{}
+	suggest := func(group []quad.IRI, reason string) {
+		canonical := group[0]
+		for _, alias := range group[1:] {
+			if suggested[alias] || aliases.Canonical(alias) == aliases.Canonical(canonical) {
+				continue
+			}
+			suggested[alias] = true
+			suggestions = append(suggestions, AliasSuggestion{Canonical: canonical, Alias: alias, Reason: reason})
+		}
+	}
+
+	owners := []dvmodel.Owner{}
+	p := path.StartPath(h).Has(quad.IRI("rdf:type"), quad.IRI("dv:Owner")).Has(quad.IRI("schema:kind"), quad.Int(dvmodel.Owner_User))
+	if err := schema.LoadPathTo(ctx, h, &owners, p); err != nil {
+		return nil, fmt.Errorf("load users: %w", err)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		a, b := owners[i], owners[j]
+		if a.CreatedAt != nil && b.CreatedAt != nil && !a.CreatedAt.Equal(*b.CreatedAt) {
+			return a.CreatedAt.Before(*b.CreatedAt)
+		}
+		if (a.CreatedAt == nil) != (b.CreatedAt == nil) {
+			return a.CreatedAt != nil
+		}
+		return a.ID < b.ID
+	})
+	byEmail := map[string][]quad.IRI{}
+	byLogin := map[string][]dvmodel.Owner{}
+	emails, logins := []string{}, []string{}
+	for _, owner := range owners {
+		if email := strings.ToLower(owner.Email); email != "" {
+			if byEmail[email] == nil {
+				emails = append(emails, email)
+			}
+			byEmail[email] = append(byEmail[email], owner.ID)
+		}
+		if login := strings.ToLower(owner.ShortName); login != "" {
+			if byLogin[login] == nil {
+				logins = append(logins, login)
+			}
+			byLogin[login] = append(byLogin[login], owner)
+		}
+	}
+	sort.Strings(emails)
+	for _, email := range emails {
+		if len(byEmail[email]) > 1 {
+			suggest(byEmail[email], fmt.Sprintf("same email: %s", email))
+		}
+	}
+	sort.Strings(logins)
+	for _, login := range logins {
+		group := []quad.IRI{}
+		drivers := map[dvmodel.Driver]bool{}
+		for _, owner := range byLogin[login] {
+			if !drivers[owner.Driver] { // a login is unique per provider
+				drivers[owner.Driver] = true
+				group = append(group, owner.ID)
+			}
+		}
+		if len(group) > 1 {
+			suggest(group, fmt.Sprintf("same login: %s", login))
+		}
+	}
+
+	tasks := dvmodel.Tasks{}
+	if err := schema.LoadPathTo(ctx, h, &tasks, path.StartPath(h).Has(quad.IRI("rdf:type"), quad.IRI("dv:Task"))); err != nil {
+		return nil, fmt.Errorf("load tasks: %w", err)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if a.UpdatedAt != nil && b.UpdatedAt != nil && !a.UpdatedAt.Equal(*b.UpdatedAt) {
+			return a.UpdatedAt.After(*b.UpdatedAt)
+		}
+		return a.ID < b.ID
+	})
+	byFingerprint := map[string][]quad.IRI{}
+	fingerprints := []string{}
+	for _, task := range tasks {
+		if task.CreatedAt == nil || task.Title == "" {
+			continue
+		}
+		fingerprint := fmt.Sprintf("%s\n%s\n%s", task.Title, aliases.Canonical(task.HasAuthor), task.CreatedAt.UTC())
+		if byFingerprint[fingerprint] == nil {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+		byFingerprint[fingerprint] = append(byFingerprint[fingerprint], task.ID)
+	}
+	sort.Strings(fingerprints)
+	for _, fingerprint := range fingerprints {
+		if group := byFingerprint[fingerprint]; len(group) > 1 {
+			suggest(group, fmt.Sprintf("same title, author and creation time: %q", strings.SplitN(fingerprint, "\n", 2)[0]))
+		}
+	}
+	return suggestions, nil
+}
+
+// expandTargets adds the other IRIs of each target, so that targeting an alias or its canonical entity is the same.
+func (a Aliases) expandTargets(targets []multipmuri.Entity) []multipmuri.Entity {
+	if len(a) == 0 {
+		return targets
+	}
+	expanded := []multipmuri.Entity{}
+	seen := map[string]bool{}
+	for _, target := range targets {
+		if !seen[target.String()] {
+			seen[target.String()] = true
+			expanded = append(expanded, target)
+		}
+		for _, iri := range a.Group(quad.IRI(target.String())) {
+			if seen[string(iri)] {
+				continue
+			}
+			entity, err := dvparser.ParseTarget(string(iri))
+			if err != nil { // not a target, e.g. an unsupported provider
+				continue
+			}
+			seen[string(iri)] = true
+			expanded = append(expanded, entity)
+		}
+	}
+	return expanded
+}
+
+// canonicalTask returns a copy of task referencing canonical entities only.
+//
+// The ID of the task is kept, see mergeTasks for the tasks that are aliases of another one.
+func (a Aliases) canonicalTask(task dvmodel.Task) dvmodel.Task {
+	if len(a) == 0 {
+		return task
+	}
+	single := func(iri quad.IRI) quad.IRI {
+		if iri == "" {
+			return iri
+		}
+		return a.Canonical(iri)
+	}
+	list := func(iris []quad.IRI, self bool) []quad.IRI {
+		if iris == nil {
+			return nil
+		}
+		canonicals := []quad.IRI{}
+		for _, iri := range iris {
+			canonical := a.Canonical(iri)
+			if !containsIRI(canonicals, canonical) && (self || canonical != a.Canonical(task.ID)) {
+				canonicals = append(canonicals, canonical)
+			}
+		}
+		return canonicals
+	}
+	task.HasAuthor = single(task.HasAuthor)
+	task.HasOwner = single(task.HasOwner)
+	task.HasMilestone = single(task.HasMilestone)
+	task.HasAssignee = list(task.HasAssignee, true)
+	task.HasReviewer = list(task.HasReviewer, true)
+	task.HasLabel = list(task.HasLabel, true)
+	task.IsDependingOn = list(task.IsDependingOn, false)
+	task.IsBlocking = list(task.IsBlocking, false)
+	task.IsRelatedWith = list(task.IsRelatedWith, false)
+	task.IsPartOf = list(task.IsPartOf, false)
+	task.HasPart = list(task.HasPart, false)
+	return task
+}
+
+// merged returns true if id is an alias of one of ids, which replaces it, see mergeTasks.
+func (a Aliases) merged(id quad.IRI, ids map[quad.IRI]bool) bool {
+	canonical := a.Canonical(id)
+	return canonical != id && ids[canonical]
+}
+
+// mergeTasks merges the tasks that are aliases of another task of tasks into it, keeping the order of tasks.
+//
+// The canonical task gets the relationships, labels, assignees and reviewers of its aliases, its other fields are kept.
+// The targets are not rewritten, see canonicalTask.
+func (a Aliases) mergeTasks(tasks dvmodel.Tasks) dvmodel.Tasks {
+	if len(a) == 0 {
+		return tasks
+	}
+	ids := make(map[quad.IRI]bool, len(tasks))
+	for _, task := range tasks {
+		ids[task.ID] = true
+	}
+	aliases := map[quad.IRI][]dvmodel.Task{}
+	for _, task := range tasks {
+		if a.merged(task.ID, ids) {
+			canonical := a.Canonical(task.ID)
+			aliases[canonical] = append(aliases[canonical], task)
+		}
+	}
+	if len(aliases) == 0 {
+		return tasks
+	}
+	merged := make(dvmodel.Tasks, 0, len(tasks))
+	for _, task := range tasks {
+		if a.merged(task.ID, ids) {
+			continue
+		}
+		union := func(iris []quad.IRI, others func(dvmodel.Task) []quad.IRI) []quad.IRI {
+			result := iris
+			for _, alias := range aliases[task.ID] {
+				for _, iri := range others(alias) {
+					if !containsIRI(result, iri) {
+						// never appended in place, the slices can be shared with a cache, see Index
+						result = append(append([]quad.IRI{}, result...), iri)
+					}
+				}
+			}
+			return result
+		}
+		task.HasAssignee = union(task.HasAssignee, func(t dvmodel.Task) []quad.IRI { return t.HasAssignee })
+		task.HasReviewer = union(task.HasReviewer, func(t dvmodel.Task) []quad.IRI { return t.HasReviewer })
+		task.HasLabel = union(task.HasLabel, func(t dvmodel.Task) []quad.IRI { return t.HasLabel })
+		task.IsDependingOn = union(task.IsDependingOn, func(t dvmodel.Task) []quad.IRI { return t.IsDependingOn })
+		task.IsBlocking = union(task.IsBlocking, func(t dvmodel.Task) []quad.IRI { return t.IsBlocking })
+		task.IsRelatedWith = union(task.IsRelatedWith, func(t dvmodel.Task) []quad.IRI { return t.IsRelatedWith })
+		task.IsPartOf = union(task.IsPartOf, func(t dvmodel.Task) []quad.IRI { return t.IsPartOf })
+		task.HasPart = union(task.HasPart, func(t dvmodel.Task) []quad.IRI { return t.HasPart })
+		merged = append(merged, task)
+	}
+	return merged
+}
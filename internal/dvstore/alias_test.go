@@ -0,0 +1,125 @@
+package dvstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestAliases(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	var (
+		moul    = quad.IRI("https://github.com/moul")
+		moulbot = quad.IRI("https://github.com/moul-bot")
+		gitlab  = quad.IRI("https://gitlab.com/moul")
+	)
+	require.NoError(t, AddAlias(ctx, store, moul, moulbot))
+	require.Error(t, AddAlias(ctx, store, moulbot, moul), "cycle")
+	require.NoError(t, AddAlias(ctx, store, moulbot, gitlab), "alias of an alias")
+	aliases, err := LoadAliases(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, Aliases{moulbot: moul, gitlab: moul}, aliases)
+	assert.Equal(t, []quad.IRI{moul, moulbot, gitlab}, aliases.Group(gitlab))
+
+	// the tasks of every identity, with canonical references
+	localIDs := func(filters LoadTasksFilters) []string {
+		tasks, err := LoadTasks(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, task := range tasks {
+			assert.NotEqual(t, moulbot, task.HasAuthor, task.LocalID)
+			ids = append(ids, task.LocalID)
+		}
+		return ids
+	}
+	byUser := localIDs(LoadTasksFilters{Targets: parseTargets(t, "@moul-bot"), WithClosed: true, WithoutExternalDeps: true})
+	assert.Contains(t, byUser, "moul/depviz-test#10")
+	assert.Contains(t, byUser, "moul-bot/depviz-test#1")
+	filter, err := ParseTaskFilter("author:moul")
+	require.NoError(t, err)
+	assert.Len(t, localIDs(LoadTasksFilters{TheWorld: true, WithClosed: true, Filter: filter}), 19)
+
+	// a transferred issue is merged into its canonical task
+	var (
+		current  = quad.IRI("https://github.com/moul/depviz-test/issues/5")
+		previous = quad.IRI("https://github.com/moul-bot/depviz-test/issues/5")
+		issue10  = quad.IRI("https://github.com/moul/depviz-test/issues/10")
+	)
+	require.NoError(t, AddAlias(ctx, store, current, previous))
+	index, err := NewIndex(ctx, store, schemaConfig)
+	require.NoError(t, err)
+	for _, filters := range []LoadTasksFilters{
+		{TheWorld: true, WithClosed: true},
+		{TheWorld: true, WithClosed: true, Index: index},
+		{Targets: parseTargets(t, "moul/depviz-test#10"), WithClosed: true, DepsDirection: DepsUpstream},
+	} {
+		tasks, err := LoadTasks(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		found := map[quad.IRI]dvmodel.Task{}
+		for _, task := range tasks {
+			found[task.ID] = task
+		}
+		assert.NotContains(t, found, previous)
+		assert.Contains(t, found, current)
+		assert.Contains(t, found[issue10].IsDependingOn, current)
+		assert.NotContains(t, found[issue10].IsDependingOn, previous)
+	}
+
+	require.NoError(t, RemoveAlias(ctx, store, gitlab))
+	assert.Error(t, RemoveAlias(ctx, store, gitlab))
+	aliases, err = LoadAliases(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, gitlab, aliases.Canonical(gitlab))
+}
+
+func TestSuggestAliases(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingStore(t)
+	defer close()
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	tx := cayley.NewTransaction()
+	w := graph.NewTxWriter(tx, graph.Add)
+	for _, entity := range []interface{}{
+		dvmodel.Owner{ID: "https://github.com/alice", Kind: dvmodel.Owner_User, ShortName: "alice", Driver: dvmodel.Driver_GitHub, CreatedAt: &created},
+		dvmodel.Owner{ID: "https://gitlab.com/alice", Kind: dvmodel.Owner_User, ShortName: "Alice", CreatedAt: &updated},
+		dvmodel.Owner{ID: "https://github.com/bob", Kind: dvmodel.Owner_User, ShortName: "bob", Driver: dvmodel.Driver_GitHub, Email: "bob@example.com"},
+		dvmodel.Owner{ID: "https://github.com/robert", Kind: dvmodel.Owner_User, ShortName: "robert", Driver: dvmodel.Driver_GitHub, Email: "Bob@example.com"},
+		dvmodel.Owner{ID: "https://github.com/carol", Kind: dvmodel.Owner_User, ShortName: "carol", Driver: dvmodel.Driver_GitHub},
+		dvmodel.Task{ID: "https://github.com/acme/old/issues/1", Kind: dvmodel.Task_Issue, Title: "Crash", HasAuthor: "https://github.com/carol", CreatedAt: &created, UpdatedAt: &created},
+		dvmodel.Task{ID: "https://github.com/acme/new/issues/7", Kind: dvmodel.Task_Issue, Title: "Crash", HasAuthor: "https://github.com/carol", CreatedAt: &created, UpdatedAt: &updated},
+		dvmodel.Task{ID: "https://github.com/acme/new/issues/8", Kind: dvmodel.Task_Issue, Title: "Crash", HasAuthor: "https://github.com/alice", CreatedAt: &created, UpdatedAt: &updated},
+	} {
+		_, err := schemaConfig.WriteAsQuads(w, entity)
+		require.NoError(t, err)
+	}
+	require.NoError(t, applyTransaction(ctx, store, tx))
+
+	suggestions, err := SuggestAliases(ctx, store, schemaConfig)
+	require.NoError(t, err)
+	expected := []AliasSuggestion{
+		{Canonical: "https://github.com/bob", Alias: "https://github.com/robert", Reason: "same email: bob@example.com"},
+		{Canonical: "https://github.com/alice", Alias: "https://gitlab.com/alice", Reason: "same login: alice"},
+		{Canonical: "https://github.com/acme/new/issues/7", Alias: "https://github.com/acme/old/issues/1", Reason: `same title, author and creation time: "Crash"`},
+	}
+	assert.Equal(t, expected, suggestions)
+
+	// accepted suggestions are not suggested again
+	require.NoError(t, AddAlias(ctx, store, expected[0].Canonical, expected[0].Alias))
+	suggestions, err = SuggestAliases(ctx, store, schemaConfig)
+	require.NoError(t, err)
+	assert.Equal(t, expected[1:], suggestions)
+}
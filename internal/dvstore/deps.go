@@ -0,0 +1,155 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// DepsDirection is the direction in which LoadTasks follows the relationships of the selected tasks.
+type DepsDirection string
+
+const (
+	// DepsBoth follows every relationship, in both directions.
+	DepsBoth DepsDirection = ""
+	// DepsUpstream follows the tasks the selected tasks depend on, e.g. what blocks a release.
+	DepsUpstream DepsDirection = "upstream"
+	// DepsDownstream follows the tasks depending on the selected tasks, e.g. what a task blocks.
+	DepsDownstream DepsDirection = "downstream"
+)
+
+// ParseDepsDirection parses the direction given to the run command or the Graph RPC,
+// "both" or an empty input follow the relationships in both directions.
+func ParseDepsDirection(input string) (DepsDirection, error) {
+	switch direction := DepsDirection(input); direction {
+	case DepsUpstream, DepsDownstream:
+		return direction, nil
+	case "both", DepsBoth:
+		return DepsBoth, nil
+	default:
+		return DepsBoth, fmt.Errorf("invalid dependency direction: %q (upstream, downstream, both)", input)
+	}
+}
+
+// depsPredicates returns the relationships to follow forward (out) and backward (in) for direction.
+//
+// A task depends on the tasks it is depending on, the tasks blocking it and its parts.
+func depsPredicates(direction DepsDirection) (out, in []quad.IRI) {
+	switch direction {
+	case DepsUpstream:
+		return []quad.IRI{"isDependingOn", "hasPart"}, []quad.IRI{"isBlocking", "isPartOf"}
+	case DepsDownstream:
+		return []quad.IRI{"isBlocking", "isPartOf"}, []quad.IRI{"isDependingOn", "hasPart"}
+	default:
+		all := make([]quad.IRI, len(taskRelationPredicates))
+		for i, predicate := range taskRelationPredicates {
+			all[i] = predicate.(quad.IRI)
+		}
+		return all, all
+	}
+}
+
+// taskRelationsByPredicate returns the relationships of task, by predicate, see taskRelationPredicates.
+func taskRelationsByPredicate(task dvmodel.Task) map[quad.IRI][]quad.IRI {
+	return map[quad.IRI][]quad.IRI{
+		"isDependingOn": task.IsDependingOn,
+		"isBlocking":    task.IsBlocking,
+		"isRelatedWith": task.IsRelatedWith,
+		"isPartOf":      task.IsPartOf,
+		"hasPart":       task.HasPart,
+	}
+}
+
+// expandDeps returns ids and the tasks reachable from them in direction, up to depth hops.
+//
+// A depth of 0 returns ids, a negative depth follows the full transitive closure.
+func expandDeps(ctx context.Context, h *cayley.Handle, ids []quad.IRI, direction DepsDirection, depth int, aliases Aliases) ([]quad.IRI, error) {
+	out, in := depsPredicates(direction)
+	outVia := make([]interface{}, len(out))
+	for i, predicate := range out {
+		outVia[i] = predicate
+	}
+	inVia := make([]interface{}, len(in))
+	for i, predicate := range in {
+		inVia[i] = predicate
+	}
+
+	return walkDeps(ids, depth, aliases, func(frontier []quad.IRI) ([]quad.IRI, error) {
+		values := make([]quad.Value, len(frontier))
+		for i, id := range frontier {
+			values[i] = id
+		}
+		start := path.StartPath(h, values...)
+		neighbours, err := start.Out(outVia...).Or(start.In(inVia...)).Iterate(ctx).AllValues(h)
+		if err != nil {
+			return nil, fmt.Errorf("load relationships: %w", err)
+		}
+		return uniqueIRIs(neighbours), nil
+	})
+}
+
+// expandDepsIn is expandDeps for an in-memory set of tasks, relationships to tasks outside of all are ignored.
+func expandDepsIn(all map[quad.IRI]dvmodel.Task, ids []quad.IRI, direction DepsDirection, depth int, aliases Aliases) []quad.IRI {
+	out, in := depsPredicates(direction)
+	reverse := reverseRelations(all)
+
+	expanded, _ := walkDeps(ids, depth, aliases, func(frontier []quad.IRI) ([]quad.IRI, error) {
+		neighbours := []quad.IRI{}
+		for _, id := range frontier {
+			relations := taskRelationsByPredicate(all[id])
+			for _, predicate := range out {
+				neighbours = append(neighbours, relations[predicate]...)
+			}
+			for _, predicate := range in {
+				neighbours = append(neighbours, reverse.sources(id, predicate)...)
+			}
+		}
+		found := neighbours[:0]
+		for _, neighbour := range neighbours {
+			if _, ok := all[neighbour]; ok {
+				found = append(found, neighbour)
+			}
+		}
+		return found, nil
+	})
+	return expanded
+}
+
+// walkDeps is a breadth-first traversal from ids, neighbours returns the tasks linked to a frontier.
+//
+// The traversal stops after depth hops, or when no new task is reached if depth is negative.
+// The aliases of a task are reached at the same time as the task.
+func walkDeps(ids []quad.IRI, depth int, aliases Aliases, neighbours func(frontier []quad.IRI) ([]quad.IRI, error)) ([]quad.IRI, error) {
+	seen := map[quad.IRI]bool{}
+	values := make([]quad.Value, 0, len(ids))
+	visit := func(ids []quad.IRI) []quad.IRI {
+		visited := []quad.IRI{}
+		for _, id := range ids {
+			group := []quad.IRI{id}
+			if len(aliases) > 0 {
+				group = aliases.Group(id)
+			}
+			for _, member := range group {
+				if !seen[member] {
+					seen[member] = true
+					visited = append(visited, member)
+					values = append(values, member)
+				}
+			}
+		}
+		return visited
+	}
+	frontier := visit(ids)
+	for hop := 0; len(frontier) > 0 && (depth < 0 || hop < depth); hop++ {
+		next, err := neighbours(frontier)
+		if err != nil {
+			return nil, err
+		}
+		frontier = visit(next)
+	}
+	return uniqueIRIs(values), nil
+}
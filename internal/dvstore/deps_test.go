@@ -0,0 +1,88 @@
+package dvstore
+
+import (
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestLoadTasksDeps(t *testing.T) {
+	logger := testutil.Logger(t)
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	world, err := LoadTasks(store, schemaConfig, LoadTasksFilters{TheWorld: true, WithClosed: true}, logger)
+	require.NoError(t, err)
+	all := map[quad.IRI]dvmodel.Task{}
+	for _, task := range world {
+		all[task.ID] = task
+	}
+
+	tests := []struct {
+		name      string
+		target    string
+		direction DepsDirection
+		depth     int
+		expected  []string
+	}{
+		{"default", "moul/depviz-test#7", DepsBoth, 0, []string{"moul/depviz-test#10", "moul/depviz-test#6", "moul/depviz-test#7"}},
+		{"one-hop", "moul/depviz-test#7", DepsBoth, 1, []string{"moul/depviz-test#10", "moul/depviz-test#6", "moul/depviz-test#7"}},
+		{"upstream", "moul/depviz-test#6", DepsUpstream, 1, []string{"moul/depviz-test#2", "moul/depviz-test#3", "moul/depviz-test#5", "moul/depviz-test#6"}},
+		{"downstream", "moul/depviz-test#6", DepsDownstream, 1, []string{"moul/depviz-test#10", "moul/depviz-test#6", "moul/depviz-test#7"}},
+		{"upstream-depth-2", "moul/depviz-test#7", DepsUpstream, 2, []string{
+			"moul-bot/depviz-test#5", "moul/depviz-test#10", "moul/depviz-test#2",
+			"moul/depviz-test#3", "moul/depviz-test#4", "moul/depviz-test#5", "moul/depviz-test#6", "moul/depviz-test#7",
+		}},
+		{"upstream-closure", "moul/depviz-test#7", DepsUpstream, -1, []string{
+			"moul-bot/depviz-test#1", "moul-bot/depviz-test#2", "moul-bot/depviz-test#3", "moul-bot/depviz-test#4",
+			"moul-bot/depviz-test#5", "moul/depviz-test#10", "moul/depviz-test#2", "moul/depviz-test#3",
+			"moul/depviz-test#4", "moul/depviz-test#5", "moul/depviz-test#6", "moul/depviz-test#7",
+		}},
+		{"downstream-closure", "moul-bot/depviz-test#4", DepsDownstream, -1, []string{
+			"moul-bot/depviz-test#4", "moul-bot/depviz-test#5", "moul-bot/depviz-test#6", "moul-bot/depviz-test#7",
+			"moul/depviz-test#10", "moul/depviz-test#7",
+		}},
+	}
+	for _, test := range tests {
+		filters := LoadTasksFilters{
+			Targets:       parseTargets(t, test.target),
+			WithClosed:    true,
+			DepsDirection: test.direction,
+			DepsDepth:     test.depth,
+		}
+		tasks, err := LoadTasks(store, schemaConfig, filters, logger)
+		require.NoError(t, err, test.name)
+		actual := []string{}
+		for _, task := range tasks {
+			actual = append(actual, task.LocalID)
+		}
+		assert.Equal(t, test.expected, actual, test.name)
+
+		// same traversal on the in-memory tasks, used by the snapshots
+		target := quad.IRI(filters.Targets[0].String())
+		expanded := expandDepsIn(all, []quad.IRI{target}, test.direction, filters.depsDepth(), nil)
+		actual = []string{}
+		for _, id := range expanded {
+			actual = append(actual, all[id].LocalID)
+		}
+		assert.ElementsMatch(t, test.expected, actual, test.name)
+	}
+
+	// a depth of 0 does not follow any relationship
+	target := quad.IRI("https://github.com/moul/depviz-test/issues/7")
+	assert.Equal(t, []quad.IRI{target}, expandDepsIn(all, []quad.IRI{target}, DepsBoth, 0, nil))
+}
+
+func TestParseDepsDirection(t *testing.T) {
+	for input, expected := range map[string]DepsDirection{"": DepsBoth, "both": DepsBoth, "upstream": DepsUpstream, "downstream": DepsDownstream} {
+		direction, err := ParseDepsDirection(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, direction, input)
+	}
+	_, err := ParseDepsDirection("sideways")
+	assert.Error(t, err)
+}
@@ -0,0 +1,324 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// ProblemKind is a class of integrity problem detected by Diagnose.
+type ProblemKind string
+
+const (
+	// DuplicatedEdge is a quad stored more than once, i.e. with different labels.
+	DuplicatedEdge ProblemKind = "duplicated-edge"
+	// DanglingRelationship is a relationship pointing at an IRI without any entity.
+	DanglingRelationship ProblemKind = "dangling-relationship"
+	// MissingType is an entity without rdf:type, invisible to the schema loader.
+	MissingType ProblemKind = "missing-type"
+	// MixedCasePredicate is a predicate only differing from a schema one by its casing.
+	MixedCasePredicate ProblemKind = "mixed-case-predicate"
+	// StaleInverse is an inverse relationship whose original relationship was removed, see MaterializeInverses.
+	StaleInverse ProblemKind = "stale-inverse"
+)
+
+// ProblemKinds lists every kind of problem, in the order they are reported.
+var ProblemKinds = []ProblemKind{MissingType, MixedCasePredicate, DuplicatedEdge, DanglingRelationship, StaleInverse}
+
+// Problem is an integrity problem and the deltas needed to repair it.
+//
+// A problem without any delta can only be reported and needs a manual fix.
+type Problem struct {
+	Kind        ProblemKind
+	Subject     quad.Value
+	Description string
+	Remove      []quad.Quad
+	Add         []quad.Quad
+}
+
+// Repairable returns true if Repair can fix the problem.
+func (p Problem) Repairable() bool {
+	return len(p.Remove) > 0 || len(p.Add) > 0
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s: %s", p.Kind, p.Subject, p.Description)
+}
+
+// entityTypes maps the registered schema types to their Go struct.
+var entityTypes = map[quad.IRI]interface{}{
+	"dv:Owner": dvmodel.Owner{},
+	"dv:Task":  dvmodel.Task{},
+	"dv:Topic": dvmodel.Topic{},
+}
+
+// Diagnose scans every quad of the store and returns the integrity problems found.
+func Diagnose(ctx context.Context, h *cayley.Handle) ([]Problem, error) {
+	quads := []quad.Quad{}
+	it := h.QuadsAllIterator()
+	defer it.Close()
+	for it.Next(ctx) {
+		q := h.Quad(it.Result())
+		if !q.IsValid() { // removed quads can still be iterated on some backends
+			continue
+		}
+		quads = append(quads, q)
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate quads: %w", err)
+	}
+	sort.Slice(quads, func(i, j int) bool {
+		return quads[i].NQuad() < quads[j].NQuad()
+	})
+
+	// predicates known by the schema, indexed by their lowercase form
+	typePredicates := map[quad.IRI]map[quad.IRI]bool{}
+	entityPredicates := map[quad.IRI]bool{}
+	canonical := map[string]quad.IRI{}
+	for _, predicate := range []quad.IRI{"rdf:type", LastSyncedAtPredicate, schemaVersionPredicate, SameAsPredicate} {
+		canonical[strings.ToLower(string(predicate))] = predicate
+	}
+	for kind, entity := range entityTypes {
+		typePredicates[kind] = structPredicates(entity)
+		for predicate := range typePredicates[kind] {
+			entityPredicates[predicate] = true
+			canonical[strings.ToLower(string(predicate))] = predicate
+		}
+	}
+	isRelation := map[quad.IRI]bool{}
+	for _, predicate := range RelationshipPredicates {
+		isRelation[predicate] = true
+	}
+	canonicalPredicate := func(q quad.Quad) (quad.IRI, bool) {
+		predicate, ok := q.Predicate.(quad.IRI)
+		if !ok {
+			return "", false
+		}
+		if found, ok := canonical[strings.ToLower(string(predicate))]; ok {
+			return found, true
+		}
+		return predicate, false
+	}
+
+	// group quads by triple, ignoring labels and predicate casing
+	type group struct {
+		predicate quad.IRI
+		quads     []quad.Quad
+	}
+	var (
+		groups     = map[string]*group{}
+		groupOrder = []string{}
+		typed      = map[string]bool{}
+		subjects   = map[string]quad.Value{}
+		predicates = map[string]map[quad.IRI]bool{}
+		inverses   = []quad.Quad{}
+	)
+	for _, q := range quads {
+		if IsInverseQuad(q) { // not a duplicate of an explicit relationship, checked below
+			inverses = append(inverses, q)
+			continue
+		}
+		predicate, _ := canonicalPredicate(q)
+		key := q.Subject.String() + " " + predicate.String() + " " + q.Object.String()
+		if _, found := groups[key]; !found {
+			groups[key] = &group{predicate: predicate}
+			groupOrder = append(groupOrder, key)
+		}
+		groups[key].quads = append(groups[key].quads, q)
+
+		subject := q.Subject.String()
+		subjects[subject] = q.Subject
+		if predicate == "rdf:type" {
+			typed[subject] = true
+		}
+		if predicates[subject] == nil {
+			predicates[subject] = map[quad.IRI]bool{}
+		}
+		predicates[subject][predicate] = true
+	}
+
+	problems := []Problem{}
+
+	// entities without rdf:type
+	subjectKeys := make([]string, 0, len(subjects))
+	for subject := range subjects {
+		subjectKeys = append(subjectKeys, subject)
+	}
+	sort.Strings(subjectKeys)
+	for _, subject := range subjectKeys {
+		if typed[subject] {
+			continue
+		}
+		used := []quad.IRI{}
+		for predicate := range predicates[subject] {
+			if entityPredicates[predicate] {
+				used = append(used, predicate)
+			}
+		}
+		if len(used) == 0 {
+			continue
+		}
+		candidates := []quad.IRI{}
+		for kind, allowed := range typePredicates {
+			matches := true
+			for _, predicate := range used {
+				if !allowed[predicate] {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				candidates = append(candidates, kind)
+			}
+		}
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+		problem := Problem{Kind: MissingType, Subject: subjects[subject]}
+		switch len(candidates) {
+		case 1:
+			problem.Description = fmt.Sprintf("missing rdf:type, inferred %s", candidates[0])
+			problem.Add = []quad.Quad{quad.Make(subjects[subject], quad.IRI("rdf:type"), candidates[0], nil)}
+			typed[subject] = true
+		case 0:
+			problem.Description = "missing rdf:type, predicates do not match any type"
+		default:
+			problem.Description = fmt.Sprintf("missing rdf:type, ambiguous type (%s)", joinIRIs(candidates))
+		}
+		problems = append(problems, problem)
+	}
+
+	for _, key := range groupOrder {
+		g := groups[key]
+
+		// relationships pointing nowhere are removed along with their duplicates
+		if isRelation[g.predicate] {
+			if object, ok := g.quads[0].Object.(quad.IRI); ok && !typed[object.String()] {
+				problems = append(problems, Problem{
+					Kind:        DanglingRelationship,
+					Subject:     g.quads[0].Subject,
+					Description: fmt.Sprintf("%s %s has no entity", g.predicate, object),
+					Remove:      g.quads,
+				})
+				continue
+			}
+		}
+
+		// keep the canonical unlabelled quad if any, else the first one
+		keeper := 0
+		for i, q := range g.quads {
+			if q.Predicate == g.predicate && q.Label == nil {
+				keeper = i
+				break
+			}
+		}
+		for i, q := range g.quads {
+			_, known := canonicalPredicate(q)
+			mixedCase := known && q.Predicate != g.predicate
+			switch {
+			case i == keeper && mixedCase:
+				fixed := q
+				fixed.Predicate = g.predicate
+				problems = append(problems, Problem{
+					Kind:        MixedCasePredicate,
+					Subject:     q.Subject,
+					Description: fmt.Sprintf("%s should be %s", q.Predicate, g.predicate),
+					Remove:      []quad.Quad{q},
+					Add:         []quad.Quad{fixed},
+				})
+			case i == keeper:
+			case mixedCase:
+				problems = append(problems, Problem{
+					Kind:        MixedCasePredicate,
+					Subject:     q.Subject,
+					Description: fmt.Sprintf("%s should be %s, already stored", q.Predicate, g.predicate),
+					Remove:      []quad.Quad{q},
+				})
+			default:
+				problems = append(problems, Problem{
+					Kind:        DuplicatedEdge,
+					Subject:     q.Subject,
+					Description: fmt.Sprintf("%s %s stored %d times", q.Predicate, q.Object, len(g.quads)),
+					Remove:      []quad.Quad{q},
+				})
+			}
+		}
+	}
+
+	// inverse relationships without their original one
+	for _, q := range inverses {
+		predicate, _ := q.Predicate.(quad.IRI)
+		key := q.Object.String() + " " + inverseRelations[predicate].String() + " " + q.Subject.String()
+		if _, found := groups[key]; found {
+			continue
+		}
+		problems = append(problems, Problem{
+			Kind:        StaleInverse,
+			Subject:     q.Subject,
+			Description: fmt.Sprintf("%s %s is not declared by %s anymore", predicate, q.Object, q.Object),
+			Remove:      []quad.Quad{q},
+		})
+	}
+
+	order := map[ProblemKind]int{}
+	for i, kind := range ProblemKinds {
+		order[kind] = i
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return order[problems[i].Kind] < order[problems[j].Kind]
+	})
+	return problems, nil
+}
+
+// Repair fixes every repairable problem in a single transaction and returns the number of fixed
+// problems. Nothing is repaired if the transaction fails.
+func Repair(ctx context.Context, h *cayley.Handle, problems []Problem) (int, error) {
+	tx := cayley.NewTransaction()
+	repaired := 0
+	for _, problem := range problems {
+		if !problem.Repairable() {
+			continue
+		}
+		for _, q := range problem.Remove {
+			tx.RemoveQuad(q)
+		}
+		for _, q := range problem.Add {
+			tx.AddQuad(q)
+		}
+		repaired++
+	}
+	if repaired == 0 {
+		return 0, nil
+	}
+	if err := applyTransaction(ctx, h, tx); err != nil {
+		return 0, fmt.Errorf("apply tx: %w", err)
+	}
+	return repaired, nil
+}
+
+// structPredicates returns the predicates declared by the quad tags of a schema struct.
+func structPredicates(entity interface{}) map[quad.IRI]bool {
+	predicates := map[quad.IRI]bool{}
+	typ := reflect.TypeOf(entity)
+	for i := 0; i < typ.NumField(); i++ {
+		tag := typ.Field(i).Tag.Get("quad")
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" || name == "@id" {
+			continue
+		}
+		predicates[quad.IRI(name)] = true
+	}
+	return predicates
+}
+
+func joinIRIs(iris []quad.IRI) string {
+	strs := make([]string, len(iris))
+	for i, iri := range iris {
+		strs[i] = string(iri)
+	}
+	return strings.Join(strs, ", ")
+}
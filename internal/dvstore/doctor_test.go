@@ -0,0 +1,110 @@
+package dvstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestDiagnoseGoldenStore(t *testing.T) {
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	problems, err := Diagnose(context.Background(), store)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestDiagnoseAndRepair(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingStore(t)
+	defer close()
+
+	var (
+		task1   = quad.IRI("https://github.com/moul/depviz-test/issues/1")
+		task2   = quad.IRI("https://github.com/moul/depviz-test/issues/2")
+		untyped = quad.IRI("https://github.com/moul/depviz-test/issues/3")
+		missing = quad.IRI("https://github.com/moul/depviz-test/issues/42")
+		repo    = quad.IRI("https://github.com/moul/depviz-test")
+	)
+	require.NoError(t, store.AddQuadSet([]quad.Quad{
+		quad.Make(repo, quad.IRI("rdf:type"), quad.IRI("dv:Owner"), nil),
+		quad.Make(task1, quad.IRI("rdf:type"), quad.IRI("dv:Task"), nil),
+		quad.Make(task1, quad.IRI("schema:kind"), quad.Int(dvmodel.Task_Issue), nil),
+		quad.Make(task1, quad.IRI("schema:state"), quad.Int(dvmodel.Task_Open), nil),
+		quad.Make(task1, quad.IRI("hasOwner"), repo, nil),
+		quad.Make(task1, quad.IRI("hasOwner"), repo, quad.IRI("imported")), // duplicated edge
+		quad.Make(task1, quad.IRI("isDependingOn"), missing, nil),          // dangling relationship
+		quad.Make(task1, quad.IRI("IsRelatedWith"), task2, nil),            // mixed-case predicate
+		quad.Make(task1, quad.IRI("IsDependingOn"), untyped, nil),          // mixed-case predicate, already stored
+		quad.Make(task1, quad.IRI("isDependingOn"), untyped, nil),          // kept
+		quad.Make(task2, quad.IRI("rdf:type"), quad.IRI("dv:Task"), nil),
+		quad.Make(task2, quad.IRI("schema:kind"), quad.Int(dvmodel.Task_Issue), nil),
+		quad.Make(task2, quad.IRI("schema:state"), quad.Int(dvmodel.Task_Open), nil),
+		quad.Make(task2, quad.IRI("isRelatedWith"), task1, task1),                      // inverse relationship
+		quad.Make(task2, quad.IRI("isBlocking"), task1, task1),                         // stale inverse relationship
+		quad.Make(untyped, quad.IRI("schema:kind"), quad.Int(dvmodel.Task_Issue), nil), // missing type
+		quad.Make(untyped, quad.IRI("schema:state"), quad.Int(dvmodel.Task_Open), nil),
+		quad.Make(untyped, quad.IRI("schema:title"), quad.String("untyped"), nil),
+	}))
+
+	problems, err := Diagnose(ctx, store)
+	require.NoError(t, err)
+	counts := map[ProblemKind]int{}
+	for _, problem := range problems {
+		counts[problem.Kind]++
+		assert.True(t, problem.Repairable(), problem.String())
+	}
+	assert.Equal(t, map[ProblemKind]int{
+		MissingType:          1,
+		MixedCasePredicate:   2,
+		DuplicatedEdge:       1,
+		DanglingRelationship: 1,
+		StaleInverse:         1,
+	}, counts)
+	assert.Equal(t, MissingType, problems[0].Kind)
+	assert.Equal(t, untyped, problems[0].Subject)
+
+	repaired, err := Repair(ctx, store, problems)
+	require.NoError(t, err)
+	assert.Equal(t, len(problems), repaired)
+
+	problems, err = Diagnose(ctx, store)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	tasks, err := LoadTasks(store, schemaConfig, LoadTasksFilters{TheWorld: true}, logger)
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+	assert.Equal(t, task1, tasks[0].ID)
+	assert.Equal(t, []quad.IRI{untyped}, tasks[0].IsDependingOn)
+	assert.Equal(t, []quad.IRI{task2}, tasks[0].IsRelatedWith)
+	assert.Equal(t, []quad.IRI{task1}, tasks[1].IsRelatedWith)
+	assert.Empty(t, tasks[1].IsBlocking)
+	assert.Equal(t, untyped, tasks[2].ID)
+	assert.Equal(t, "untyped", tasks[2].Title)
+}
+
+func TestDiagnoseAmbiguousType(t *testing.T) {
+	store, close := TestingStore(t)
+	defer close()
+
+	subject := quad.IRI("https://github.com/moul")
+	require.NoError(t, store.AddQuad(quad.Make(subject, quad.IRI("schema:localId"), quad.String("moul"), nil)))
+
+	problems, err := Diagnose(context.Background(), store)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, MissingType, problems[0].Kind)
+	assert.False(t, problems[0].Repairable())
+
+	repaired, err := Repair(context.Background(), store, problems)
+	require.NoError(t, err)
+	assert.Equal(t, 0, repaired)
+}
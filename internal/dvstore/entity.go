@@ -0,0 +1,85 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// LoadTopics loads the labels of tasks, by IRI.
+func LoadTopics(ctx context.Context, h *cayley.Handle, schema *schema.Config, tasks dvmodel.Tasks) (map[quad.IRI]dvmodel.Topic, error) {
+	ids := []quad.IRI{}
+	for _, task := range tasks {
+		ids = append(ids, task.HasLabel...)
+	}
+	topics := []dvmodel.Topic{}
+	if err := loadEntities(ctx, h, schema, &topics, ids); err != nil {
+		return nil, fmt.Errorf("load topics: %w", err)
+	}
+	loaded := map[quad.IRI]dvmodel.Topic{}
+	for _, topic := range topics {
+		loaded[topic.ID] = topic
+	}
+	return loaded, nil
+}
+
+// LoadOwners loads the owners referenced by tasks and topics (repos, authors, assignees, reviewers) and their own
+// owners, e.g. the organization of a repo, by IRI.
+func LoadOwners(ctx context.Context, h *cayley.Handle, schema *schema.Config, tasks dvmodel.Tasks, topics map[quad.IRI]dvmodel.Topic) (map[quad.IRI]dvmodel.Owner, error) {
+	ids := []quad.IRI{}
+	for _, task := range tasks {
+		ids = append(ids, task.HasOwner, task.HasAuthor)
+		ids = append(ids, task.HasAssignee...)
+		ids = append(ids, task.HasReviewer...)
+	}
+	for _, topic := range topics {
+		ids = append(ids, topic.HasOwner)
+	}
+
+	loaded := map[quad.IRI]dvmodel.Owner{}
+	for len(ids) > 0 {
+		missing := []quad.IRI{}
+		for _, id := range ids {
+			if _, found := loaded[id]; !found && id != "" {
+				missing = append(missing, id)
+			}
+		}
+		owners := []dvmodel.Owner{}
+		if err := loadEntities(ctx, h, schema, &owners, missing); err != nil {
+			return nil, fmt.Errorf("load owners: %w", err)
+		}
+		ids = nil
+		for _, owner := range owners {
+			loaded[owner.ID] = owner
+			ids = append(ids, owner.HasOwner)
+		}
+	}
+	return loaded, nil
+}
+
+// loadEntities loads the stored entities identified by ids into dst, a pointer to a slice, in chunks.
+func loadEntities(ctx context.Context, h *cayley.Handle, schema *schema.Config, dst interface{}, ids []quad.IRI) error {
+	seen := map[quad.IRI]bool{}
+	values := []quad.Value{}
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			values = append(values, id)
+		}
+	}
+	for start := 0; start < len(values); start += taskLoadChunk {
+		end := start + taskLoadChunk
+		if end > len(values) {
+			end = len(values)
+		}
+		if err := schema.LoadPathTo(ctx, h, dst, path.StartPath(h, values[start:end]...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
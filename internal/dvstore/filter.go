@@ -0,0 +1,422 @@
+package dvstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// TaskFilter is a parsed task filter expression, see ParseTaskFilter.
+type TaskFilter struct {
+	input string
+	root  filterNode
+}
+
+// ParseTaskFilter parses a filter expression, e.g. `label:backend -label:wontfix assignee:alice updated:>2026-01-01`.
+//
+// An expression is a list of terms that all have to match. Terms can be negated with a leading "-" or NOT,
+// combined with OR (which binds less tightly than the implicit AND) and grouped with parentheses.
+// Values containing spaces are quoted, e.g. `label:"good first issue"`.
+//
+// The supported terms are:
+//   - label:, assignee:, author:, milestone: and repo:, matching the end of the IRIs, e.g. repo:moul/depviz or label:bug,
+//   - kind: (issue, pr, milestone, epic, story or card) and state: (open or closed),
+//   - created:, updated:, due: and completed:, with a date or a time, compared with <, <=, >, >= or a range (2026-01-01..2026-01-31),
+//   - text: or a bare word, matching the title, labels, authors and description.
+//
+// A blank expression returns a nil filter, which matches every task.
+func ParseTaskFilter(input string) (*TaskFilter, error) {
+	tokens, err := lexFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	parser := filterParser{tokens: tokens}
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", input, err)
+	}
+	if parser.pos < len(parser.tokens) {
+		return nil, fmt.Errorf("invalid filter %q: unexpected %q", input, parser.tokens[parser.pos].value)
+	}
+	return &TaskFilter{input: strings.TrimSpace(input), root: root}, nil
+}
+
+// Match returns true if task matches the filter, a nil filter matches every task.
+func (f *TaskFilter) Match(task dvmodel.Task) bool {
+	if f == nil {
+		return true
+	}
+	return f.root.match(&task)
+}
+
+func (f *TaskFilter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.input
+}
+
+func (f *TaskFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+type filterNode interface {
+	match(task *dvmodel.Task) bool
+}
+
+type (
+	filterAnd  []filterNode
+	filterOr   []filterNode
+	filterNot  struct{ node filterNode }
+	filterTerm func(task *dvmodel.Task) bool
+)
+
+func (n filterAnd) match(task *dvmodel.Task) bool {
+	for _, node := range n {
+		if !node.match(task) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n filterOr) match(task *dvmodel.Task) bool {
+	for _, node := range n {
+		if node.match(task) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n filterNot) match(task *dvmodel.Task) bool { return !n.node.match(task) }
+
+func (n filterTerm) match(task *dvmodel.Task) bool { return n(task) }
+
+type filterToken struct {
+	value  string
+	quoted bool
+	// negated is set for the words with a leading "-", that is not part of value.
+	negated bool
+}
+
+func (t filterToken) is(keyword string) bool { return !t.quoted && !t.negated && t.value == keyword }
+
+// lexFilter splits a filter expression into words and parentheses, quotes are removed from the words.
+func lexFilter(input string) ([]filterToken, error) {
+	tokens := []filterToken{}
+	var (
+		current  strings.Builder
+		inWord   bool
+		quoted   bool
+		inQuotes bool
+	)
+	flush := func() {
+		if inWord {
+			token := filterToken{value: current.String(), quoted: quoted}
+			if !quoted && len(token.value) > 1 && strings.HasPrefix(token.value, "-") {
+				token.value, token.negated = token.value[1:], true
+			}
+			tokens = append(tokens, token)
+		}
+		current.Reset()
+		inWord, quoted = false, false
+	}
+	for _, r := range input {
+		switch {
+		case r == '"':
+			if !inWord { // a quoted word is never a keyword nor a field
+				quoted = true
+			}
+			inQuotes = !inQuotes
+			inWord = true
+		case inQuotes:
+			current.WriteRune(r)
+		case unicode.IsSpace(r):
+			flush()
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, filterToken{value: string(r)})
+		default:
+			current.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("invalid filter %q: unterminated quote", input)
+	}
+	flush()
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peek() (filterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	nodes := filterOr{}
+	for {
+		node, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		if token, ok := p.peek(); !ok || !token.is("OR") {
+			break
+		}
+		p.pos++
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	nodes := filterAnd{}
+	for {
+		token, ok := p.peek()
+		if !ok || token.is(")") || token.is("OR") {
+			break
+		}
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	switch len(nodes) {
+	case 0:
+		return nil, fmt.Errorf("empty expression")
+	case 1:
+		return nodes[0], nil
+	default:
+		return nodes, nil
+	}
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	token, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	switch {
+	case token.is("NOT") || token.is("-"):
+		p.pos++
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return filterNot{node}, nil
+	case token.is("("):
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token, ok := p.peek(); !ok || !token.is(")") {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return node, nil
+	default:
+		p.pos++
+		node, err := parseFilterTerm(token)
+		if err != nil || !token.negated {
+			return node, err
+		}
+		return filterNot{node}, nil
+	}
+}
+
+// parseFilterTerm parses a field:value term, or a bare word.
+func parseFilterTerm(token filterToken) (filterNode, error) {
+	field, value := "text", token.value
+	if i := strings.Index(token.value, ":"); i > 0 && !token.quoted {
+		field, value = strings.ToLower(token.value[:i]), token.value[i+1:]
+	}
+	if value == "" {
+		return nil, fmt.Errorf("missing value for %q", field)
+	}
+
+	switch field {
+	case "label":
+		return filterTerm(func(task *dvmodel.Task) bool { return matchIRIs(task.HasLabel, value) }), nil
+	case "assignee":
+		return filterTerm(func(task *dvmodel.Task) bool { return matchIRIs(task.HasAssignee, value) }), nil
+	case "author":
+		return filterTerm(func(task *dvmodel.Task) bool { return matchIRI(task.HasAuthor, value) }), nil
+	case "milestone":
+		return filterTerm(func(task *dvmodel.Task) bool { return matchIRI(task.HasMilestone, value) }), nil
+	case "repo":
+		return filterTerm(func(task *dvmodel.Task) bool { return matchIRI(task.HasOwner, value) }), nil
+	case "kind":
+		kind, err := parseFilterKind(value)
+		if err != nil {
+			return nil, err
+		}
+		return filterTerm(func(task *dvmodel.Task) bool { return task.Kind == kind }), nil
+	case "state":
+		var state dvmodel.Task_State
+		switch strings.ToLower(value) {
+		case "open":
+			state = dvmodel.Task_Open
+		case "closed":
+			state = dvmodel.Task_Closed
+		default:
+			return nil, fmt.Errorf("invalid state: %q (open, closed)", value)
+		}
+		return filterTerm(func(task *dvmodel.Task) bool { return task.State == state }), nil
+	case "created", "updated", "due", "completed":
+		from, to, err := parseFilterTimeRange(value)
+		if err != nil {
+			return nil, err
+		}
+		return filterTerm(func(task *dvmodel.Task) bool {
+			var t *time.Time
+			switch field {
+			case "created":
+				t = task.CreatedAt
+			case "updated":
+				t = task.UpdatedAt
+			case "due":
+				t = task.DueOn
+			case "completed":
+				t = task.CompletedAt
+			}
+			return t != nil && !t.Before(from) && !t.After(to)
+		}), nil
+	case "text":
+		terms := searchTerms(value)
+		if len(terms) == 0 {
+			return nil, fmt.Errorf("text too short: %q", value)
+		}
+		return filterTerm(func(task *dvmodel.Task) bool {
+			words := map[string]bool{}
+			for _, text := range searchDocument(*task) {
+				for _, word := range searchTerms(text) {
+					words[word] = true
+				}
+			}
+			for _, term := range terms {
+				if !words[term] {
+					return false
+				}
+			}
+			return true
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown field: %q", field)
+	}
+}
+
+// matchIRI returns true if iri is value, or ends with "/" + value, case-insensitively.
+//
+// This matches "bug" or "moul/depviz/labels/bug" with a label IRI, and "moul/depviz" with a repo IRI.
+func matchIRI(iri quad.IRI, value string) bool {
+	if iri == "" {
+		return false
+	}
+	name := strings.ToLower(strings.TrimSuffix(string(iri), "/"))
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	value = strings.ToLower(strings.TrimPrefix(value, "@"))
+	return name == value || strings.HasSuffix(name, "/"+value)
+}
+
+func matchIRIs(iris []quad.IRI, value string) bool {
+	for _, iri := range iris {
+		if matchIRI(iri, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFilterKind(input string) (dvmodel.Task_Kind, error) {
+	normalized := strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(input))
+	switch normalized {
+	case "pr", "mr", "pullrequest":
+		return dvmodel.Task_MergeRequest, nil
+	}
+	for value, name := range dvmodel.Task_Kind_name {
+		if value != 0 && strings.ToLower(name) == normalized {
+			return dvmodel.Task_Kind(value), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid kind: %q (issue, pr, milestone, epic, story, card)", input)
+}
+
+// parseFilterTimeRange parses a time condition into an inclusive range.
+//
+// Dates are whole days (UTC), so ">2026-01-01" starts on January 2nd and "2026-01-01" is the whole day.
+func parseFilterTimeRange(input string) (from, to time.Time, err error) {
+	from, to = time.Time{}, time.Unix(1<<62, 0)
+	if i := strings.Index(input, ".."); i >= 0 {
+		if start := input[:i]; start != "*" && start != "" {
+			if from, _, err = parseFilterTime(start); err != nil {
+				return
+			}
+		}
+		if end := input[i+2:]; end != "*" && end != "" {
+			if _, to, err = parseFilterTime(end); err != nil {
+				return
+			}
+		}
+		return from, to, nil
+	}
+
+	for _, operator := range []string{">=", "<=", ">", "<", "="} {
+		if !strings.HasPrefix(input, operator) {
+			continue
+		}
+		start, end, err := parseFilterTime(input[len(operator):])
+		if err != nil {
+			return from, to, err
+		}
+		switch operator {
+		case ">=":
+			from = start
+		case "<=":
+			to = end
+		case ">":
+			from = end.Add(time.Nanosecond)
+		case "<":
+			to = start.Add(-time.Nanosecond)
+		case "=":
+			from, to = start, end
+		}
+		return from, to, nil
+	}
+	return parseFilterTime(input)
+}
+
+// parseFilterTime parses an RFC 3339 time or a YYYY-MM-DD date, returning the first and last instant it covers.
+func parseFilterTime(input string) (start, end time.Time, err error) {
+	if t, err := time.Parse(time.RFC3339, input); err == nil {
+		return t, t, nil
+	}
+	day, err := time.Parse("2006-01-02", input)
+	if err != nil {
+		return start, end, fmt.Errorf("invalid date: %q (YYYY-MM-DD or RFC 3339)", input)
+	}
+	return day, day.Add(24*time.Hour - time.Nanosecond), nil
+}
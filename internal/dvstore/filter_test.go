@@ -0,0 +1,109 @@
+package dvstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestTaskFilter(t *testing.T) {
+	updated := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	task := dvmodel.Task{
+		ID:          "https://github.com/moul/depviz/issues/42",
+		Kind:        dvmodel.Task_Issue,
+		State:       dvmodel.Task_Open,
+		Title:       "Rewrite the backend",
+		UpdatedAt:   &updated,
+		HasAuthor:   "https://github.com/moul",
+		HasOwner:    "https://github.com/moul/depviz",
+		HasAssignee: []quad.IRI{"https://github.com/alice"},
+		HasLabel:    []quad.IRI{"https://github.com/moul/depviz/labels/backend", "https://github.com/moul/depviz/labels/good%20first%20issue"},
+	}
+
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"", true},
+		{"label:backend -label:wontfix assignee:alice updated:>2026-01-01", true},
+		{"label:backend label:wontfix", false},
+		{"label:wontfix OR label:backend", true},
+		{"label:wontfix OR kind:pr", false},
+		{"label:\"good first issue\"", true},
+		{"label:moul/depviz/labels/backend", true},
+		{"-label:backend", false},
+		{"NOT (label:wontfix OR state:closed)", true},
+		{"- ( label:backend )", false},
+		{"-rewrite OR kind:issue", true},
+		{"rewrite -NOT", true}, // a negated word is never a keyword
+		{"(label:wontfix OR author:@moul) repo:moul/depviz", true},
+		{"repo:depviz-test", false},
+		{"kind:issue state:open", true},
+		{"milestone:1", false},
+		{"updated:2026-01-15", true},
+		{"updated:<2026-01-15", false},
+		{"updated:<=2026-01-15", true},
+		{"updated:>2026-01-15", false},
+		{"updated:2026-01-01..2026-01-31", true},
+		{"updated:2026-02-01..*", false},
+		{"updated:>=2026-01-15T10:00:00Z", true},
+		{"due:>2026-01-01", false},
+		{"backend rewrite", true},
+		{"text:\"rewrite frontend\"", false},
+		{"\"label:backend\"", false},
+	}
+	for _, test := range tests {
+		filter, err := ParseTaskFilter(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.expected, filter.Match(task), test.input)
+	}
+
+	tokens, err := lexFilter(`-label:backend - "-quoted"`)
+	require.NoError(t, err)
+	assert.Equal(t, []filterToken{{value: "label:backend", negated: true}, {value: "-"}, {value: "-quoted", quoted: true}}, tokens)
+
+	for _, input := range []string{
+		"label:",
+		"unknown:value",
+		"kind:bug",
+		"state:pending",
+		"updated:>yesterday",
+		"(label:backend",
+		"label:backend)",
+		"label:backend OR",
+		"\"unterminated",
+		"a",
+	} {
+		_, err := ParseTaskFilter(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestLoadTasksFilter(t *testing.T) {
+	logger := testutil.Logger(t)
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	filter, err := ParseTaskFilter("repo:moul/depviz-test (label:bug OR milestone:1) -label:documentation")
+	require.NoError(t, err)
+	filters := LoadTasksFilters{TheWorld: true, WithClosed: true, WithoutExternalDeps: true, Filter: filter}
+	tasks, err := LoadTasks(store, schemaConfig, filters, logger)
+	require.NoError(t, err)
+	actual := []string{}
+	for _, task := range tasks {
+		actual = append(actual, task.LocalID)
+	}
+	expected := []string{"moul/depviz-test#7", "moul/depviz-test#8", "moul/depviz-test#9"}
+	assert.Equal(t, expected, actual)
+
+	// the relationships of the matching tasks are still followed
+	filters.WithoutExternalDeps = false
+	tasks, err = LoadTasks(store, schemaConfig, filters, logger)
+	require.NoError(t, err)
+	assert.Greater(t, len(tasks), len(expected))
+}
@@ -0,0 +1,244 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// Index is an in-memory copy of the tasks of a store and of their relationships.
+//
+// When set in LoadTasksFilters, the tasks are selected and traversed in memory instead of with path queries.
+// It is built with NewIndex and has to be refreshed after each write, see Refresh and Reload.
+type Index struct {
+	mu      sync.RWMutex
+	tasks   map[quad.IRI]dvmodel.Task
+	reverse relationIndex
+}
+
+// NewIndex builds the index of the tasks stored in h.
+func NewIndex(ctx context.Context, h *cayley.Handle, schema *schema.Config) (*Index, error) {
+	idx := Index{}
+	if err := idx.Reload(ctx, h, schema); err != nil {
+		return nil, err
+	}
+	return &idx, nil
+}
+
+// Reload rebuilds the index from scratch, e.g. after pruning the store.
+func (idx *Index) Reload(ctx context.Context, h *cayley.Handle, schema *schema.Config) error {
+	tasks := dvmodel.Tasks{}
+	p := path.StartPath(h).Has(quad.IRI("rdf:type"), quad.IRI("dv:Task"))
+	if err := schema.LoadPathTo(ctx, h, &tasks, p); err != nil {
+		return fmt.Errorf("load tasks: %w", err)
+	}
+	all := make(map[quad.IRI]dvmodel.Task, len(tasks))
+	for _, task := range tasks {
+		all[task.ID] = task
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.tasks = all
+	idx.reverse = reverseRelations(all)
+	return nil
+}
+
+// Refresh reloads the tasks identified by ids from the store, and the tasks they are related with before and after,
+// which may have gained or lost inverse relationships. A nil index is ignored.
+func (idx *Index) Refresh(ctx context.Context, h *cayley.Handle, schema *schema.Config, ids []quad.IRI) error {
+	if idx == nil || len(ids) == 0 {
+		return nil
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	loaded, err := loadTasksByID(ctx, h, schema, ids)
+	if err != nil {
+		return err
+	}
+	affected := map[quad.IRI]bool{}
+	for _, id := range ids {
+		affected[id] = true
+	}
+	neighbours := map[quad.IRI]bool{}
+	for _, id := range ids {
+		for _, neighbour := range idx.neighbours(id) {
+			neighbours[neighbour] = true
+		}
+		if task, found := loaded[id]; found {
+			for _, relation := range task.AllRelations() {
+				neighbours[relation] = true
+			}
+		}
+	}
+	others := []quad.IRI{}
+	for id := range neighbours {
+		if !affected[id] {
+			affected[id] = true
+			others = append(others, id)
+		}
+	}
+	reloaded, err := loadTasksByID(ctx, h, schema, others)
+	if err != nil {
+		return err
+	}
+	for id, task := range reloaded {
+		loaded[id] = task
+	}
+
+	for id := range affected {
+		if old, found := idx.tasks[id]; found {
+			idx.reverse.remove(old)
+			delete(idx.tasks, id)
+		}
+		if task, found := loaded[id]; found {
+			idx.tasks[id] = task
+			idx.reverse.add(task)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of indexed tasks.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.tasks)
+}
+
+// neighbours returns the tasks related with id, in both directions.
+func (idx *Index) neighbours(id quad.IRI) []quad.IRI {
+	neighbours := []quad.IRI{}
+	if task, found := idx.tasks[id]; found {
+		neighbours = append(neighbours, task.AllRelations()...)
+	}
+	for _, sources := range idx.reverse[id] {
+		for source := range sources {
+			neighbours = append(neighbours, source)
+		}
+	}
+	return neighbours
+}
+
+// loadTasks is LoadTasks for the indexed tasks, with the same semantics as taskIDs.
+func (idx *Index) loadTasks(ctx context.Context, h *cayley.Handle, filters LoadTasksFilters, aliases Aliases) (dvmodel.Tasks, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	selected, err := selectTasks(ctx, h, idx.tasks, filters, aliases)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]quad.IRI, 0, len(selected))
+	for id := range selected {
+		ids = append(ids, id)
+	}
+
+	if !filters.WithoutExternalDeps {
+		// unlike expandDepsIn, the traversal goes through the tasks missing from the store, like expandDeps
+		out, in := depsPredicates(filters.DepsDirection)
+		ids, _ = walkDeps(ids, filters.depsDepth(), aliases, func(frontier []quad.IRI) ([]quad.IRI, error) {
+			neighbours := []quad.IRI{}
+			for _, id := range frontier {
+				relations := taskRelationsByPredicate(idx.tasks[id])
+				for _, predicate := range out {
+					neighbours = append(neighbours, relations[predicate]...)
+				}
+				for _, predicate := range in {
+					neighbours = append(neighbours, idx.reverse.sources(id, predicate)...)
+				}
+			}
+			return neighbours, nil
+		})
+	}
+
+	tasks := make(dvmodel.Tasks, 0, len(ids))
+	for _, id := range ids {
+		if task, found := idx.tasks[id]; found {
+			tasks = append(tasks, task)
+		}
+	}
+	if filters.WithoutIsolated {
+		targeted := dvmodel.TargetedTasks(tasks)
+		kept := make(dvmodel.Tasks, 0, len(tasks))
+		for _, task := range tasks {
+			if targeted[task.ID] {
+				kept = append(kept, task)
+			}
+		}
+		tasks = kept
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+	return tasks, nil
+}
+
+// loadTasksByID loads the stored tasks identified by ids, in chunks.
+func loadTasksByID(ctx context.Context, h *cayley.Handle, schema *schema.Config, ids []quad.IRI) (map[quad.IRI]dvmodel.Task, error) {
+	loaded := map[quad.IRI]dvmodel.Task{}
+	err := loadTaskChunks(ctx, h, schema, ids, func(tasks dvmodel.Tasks) {
+		for _, task := range tasks {
+			loaded[task.ID] = task
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loaded, nil
+}
+
+// relationIndex maps a task to the tasks having a relationship with it, by predicate.
+type relationIndex map[quad.IRI]map[quad.IRI]map[quad.IRI]bool
+
+// reverseRelations returns the relationIndex of all.
+func reverseRelations(all map[quad.IRI]dvmodel.Task) relationIndex {
+	reverse := relationIndex{}
+	for _, task := range all {
+		reverse.add(task)
+	}
+	return reverse
+}
+
+func (r relationIndex) add(task dvmodel.Task) {
+	for predicate, targets := range taskRelationsByPredicate(task) {
+		for _, target := range targets {
+			if r[target] == nil {
+				r[target] = map[quad.IRI]map[quad.IRI]bool{}
+			}
+			if r[target][predicate] == nil {
+				r[target][predicate] = map[quad.IRI]bool{}
+			}
+			r[target][predicate][task.ID] = true
+		}
+	}
+}
+
+func (r relationIndex) remove(task dvmodel.Task) {
+	for predicate, targets := range taskRelationsByPredicate(task) {
+		for _, target := range targets {
+			delete(r[target][predicate], task.ID)
+			if len(r[target][predicate]) == 0 {
+				delete(r[target], predicate)
+			}
+			if len(r[target]) == 0 {
+				delete(r, target)
+			}
+		}
+	}
+}
+
+// sources returns the tasks having a predicate relationship with id.
+func (r relationIndex) sources(id, predicate quad.IRI) []quad.IRI {
+	sources := make([]quad.IRI, 0, len(r[id][predicate]))
+	for source := range r[id][predicate] {
+		sources = append(sources, source)
+	}
+	return sources
+}
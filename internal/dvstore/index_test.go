@@ -0,0 +1,162 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+	"moul.io/godev"
+)
+
+func TestIndexLoadTasks(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+	index, err := NewIndex(ctx, store, schemaConfig)
+	require.NoError(t, err)
+	assert.Equal(t, 19, index.Len())
+
+	filter, err := ParseTaskFilter("label:bug OR milestone:1")
+	require.NoError(t, err)
+	tests := []LoadTasksFilters{
+		{TheWorld: true},
+		{TheWorld: true, WithoutPRs: true, WithoutExternalDeps: true},
+		{TheWorld: true, WithClosed: true},
+		{TheWorld: true, WithoutIsolated: true},
+		{TheWorld: true, WithClosed: true, WithoutPRs: true, WithoutIsolated: true, WithoutExternalDeps: true},
+		{TheWorld: true, WithClosed: true, Filter: filter},
+		{TheWorld: true, WithClosed: true, After: "https://github.com/moul/depviz-test/issues/3", Limit: 5},
+		{Targets: parseTargets(t, "moul/depviz-test")},
+		{Targets: parseTargets(t, "moul/depviz-test, moul-bot/depviz-test")},
+		{Targets: parseTargets(t, "moul/depviz-test#7")},
+		{Targets: parseTargets(t, "moul/depviz-test#7"), WithClosed: true, DepsDirection: DepsUpstream, DepsDepth: -1},
+		{Targets: parseTargets(t, "moul-bot/depviz-test#4"), WithClosed: true, DepsDirection: DepsDownstream, DepsDepth: 2},
+		{Targets: parseTargets(t, "https://github.com/moul/depviz-test/milestone/1"), WithoutExternalDeps: true},
+		{Targets: parseTargets(t, "moul/depviz-test/labels/bug"), WithClosed: true},
+		{Targets: parseTargets(t, "@moul-bot"), WithoutExternalDeps: true},
+		{Targets: parseTargets(t, "@moul")},
+	}
+	for _, filters := range tests {
+		name := godev.JSON(filters)
+		expected, err := LoadTasksPage(store, schemaConfig, filters, logger)
+		require.NoError(t, err, name)
+		filters.Index = index
+		actual, err := LoadTasksPage(store, schemaConfig, filters, logger)
+		require.NoError(t, err, name)
+		assert.Equal(t, godev.JSON(expected), godev.JSON(actual), name)
+	}
+}
+
+func TestIndexRefresh(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+	index, err := NewIndex(ctx, store, schemaConfig)
+	require.NoError(t, err)
+
+	var (
+		issue2  = quad.IRI("https://github.com/moul/depviz-test/issues/2")
+		issue7  = quad.IRI("https://github.com/moul/depviz-test/issues/7")
+		issue10 = quad.IRI("https://github.com/moul/depviz-test/issues/10")
+	)
+	tx := cayley.NewTransaction()
+	// #7 is blocked by #2 too
+	tx.AddQuad(quad.Make(issue7, quad.IRI("isDependingOn"), issue2, nil))
+	// #10 disappears
+	quads, err := quadsWith(ctx, store, quad.Subject, issue10)
+	require.NoError(t, err)
+	for _, q := range quads {
+		tx.RemoveQuad(q)
+	}
+	require.NoError(t, applyTransaction(ctx, store, tx))
+	require.NoError(t, index.Refresh(ctx, store, schemaConfig, []quad.IRI{issue7, issue10}))
+
+	rebuilt, err := NewIndex(ctx, store, schemaConfig)
+	require.NoError(t, err)
+	assert.Equal(t, rebuilt.Len(), index.Len())
+	assert.Equal(t, godev.JSON(rebuilt.tasks), godev.JSON(index.tasks))
+	assert.Equal(t, godev.JSON(rebuilt.reverse), godev.JSON(index.reverse))
+	assert.Contains(t, index.reverse.sources(issue2, "isDependingOn"), issue7)
+
+	var nilIndex *Index
+	assert.NoError(t, nilIndex.Refresh(ctx, store, schemaConfig, []quad.IRI{issue7}))
+}
+
+// BenchmarkLoadTasks compares the path queries with the index on a synthetic store.
+//
+//	go test ./internal/dvstore -run ^$ -bench LoadTasks -benchmem
+func BenchmarkLoadTasks(b *testing.B) {
+	ctx := context.Background()
+	store, close := TestingStore(b)
+	defer close()
+	const repos, tasksPerRepo = 10, 1000
+	writeSyntheticTasks(b, store, repos, tasksPerRepo)
+	index, err := NewIndex(ctx, store, schemaConfig)
+	require.NoError(b, err)
+
+	targets := map[string]LoadTasksFilters{
+		"repo":    {Targets: parseTargets(b, "bench/repo-0"), WithClosed: true},
+		"task":    {Targets: parseTargets(b, "bench/repo-3#500"), WithClosed: true, DepsDirection: DepsUpstream, DepsDepth: -1},
+		"world":   {TheWorld: true, WithoutExternalDeps: true},
+		"filters": {TheWorld: true, WithoutPRs: true, WithoutIsolated: true, DepsDepth: 2},
+	}
+	for _, name := range []string{"repo", "task", "world", "filters"} {
+		for _, withIndex := range []bool{false, true} {
+			filters := targets[name]
+			mode := "path"
+			if withIndex {
+				mode = "index"
+				filters.Index = index
+			}
+			b.Run(name+"/"+mode, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_, err := LoadTasks(store, schemaConfig, filters, zap.NewNop())
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
+
+// writeSyntheticTasks stores repos of n issues, each depending on the previous one and related with the next repo.
+func writeSyntheticTasks(tb testing.TB, h *cayley.Handle, repos, n int) {
+	tb.Helper()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tx := cayley.NewTransaction()
+	w := graph.NewTxWriter(tx, graph.Add)
+	for r := 0; r < repos; r++ {
+		repo := quad.IRI(fmt.Sprintf("https://github.com/bench/repo-%d", r))
+		_, err := schemaConfig.WriteAsQuads(w, dvmodel.Owner{ID: repo, Kind: dvmodel.Owner_Repo, LocalID: fmt.Sprintf("bench/repo-%d", r)})
+		require.NoError(tb, err)
+		for i := 1; i <= n; i++ {
+			task := dvmodel.Task{
+				ID:        quad.IRI(fmt.Sprintf("%s/issues/%d", string(repo), i)),
+				LocalID:   fmt.Sprintf("bench/repo-%d#%d", r, i),
+				Kind:      dvmodel.Task_Issue,
+				State:     dvmodel.Task_State(1 + i%2),
+				Title:     fmt.Sprintf("synthetic issue %d", i),
+				CreatedAt: &created,
+				HasOwner:  repo,
+			}
+			if i > 1 {
+				task.IsDependingOn = []quad.IRI{quad.IRI(fmt.Sprintf("%s/issues/%d", string(repo), i-1))}
+			}
+			if i%100 == 0 {
+				task.IsRelatedWith = []quad.IRI{quad.IRI(fmt.Sprintf("https://github.com/bench/repo-%d/issues/%d", (r+1)%repos, i))}
+			}
+			_, err := schemaConfig.WriteAsQuads(w, task)
+			require.NoError(tb, err)
+		}
+	}
+	require.NoError(tb, applyTransaction(context.Background(), h, tx))
+}
@@ -0,0 +1,284 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/graph/shape"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// inverseRelations maps each relationship between tasks to its inverse.
+var inverseRelations = map[quad.IRI]quad.IRI{
+	"isDependingOn": "isBlocking",
+	"isBlocking":    "isDependingOn",
+	"isPartOf":      "hasPart",
+	"hasPart":       "isPartOf",
+	"isRelatedWith": "isRelatedWith",
+}
+
+// IsInverseQuad returns true if q is a relationship materialised by MaterializeInverses.
+//
+// Inverse quads are labelled with their provenance, the task declaring the original relationship,
+// which is also their object: "#5 isDependingOn #10" is stored with the #10 label when #10 is blocking #5.
+func IsInverseQuad(q quad.Quad) bool {
+	predicate, ok := q.Predicate.(quad.IRI)
+	if !ok || q.Label == nil {
+		return false
+	}
+	_, isRelation := inverseRelations[predicate]
+	return isRelation && q.Label == q.Object
+}
+
+// MaterializeInverses adds the deltas keeping the inverse relationships of tasks up to date to tx,
+// so that reading a single task gives the full picture, e.g. #5 is depending on #10 when #10 is blocking #5.
+//
+// Inverse relationships are only stored on tasks known by the store or part of tasks, and are removed
+// when the original relationship disappears. Explicit relationships stay untouched, see ExplicitRelations.
+func MaterializeInverses(ctx context.Context, h *cayley.Handle, tx *graph.Transaction, tasks []*dvmodel.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	predicates := make([]quad.Value, 0, len(inverseRelations))
+	for predicate := range inverseRelations {
+		predicates = append(predicates, predicate)
+	}
+	batch := map[quad.IRI]bool{}
+	ids := make([]quad.Value, len(tasks))
+	for i, task := range tasks {
+		batch[task.ID] = true
+		ids[i] = task.ID
+	}
+
+	// the relationships of the stored tasks and the ones pointing at them, with a query per direction
+	incoming := map[quad.IRI][]quad.Quad{}
+	quads, err := quadsWithAny(ctx, h, quad.Object, ids, predicates...)
+	if err != nil {
+		return err
+	}
+	for _, q := range quads {
+		object, _ := q.Object.(quad.IRI)
+		incoming[object] = append(incoming[object], q)
+	}
+	existing := map[quad.Quad]bool{}
+	quads, err = quadsWithAny(ctx, h, quad.Subject, ids, predicates...)
+	if err != nil {
+		return err
+	}
+	for _, q := range quads {
+		existing[q] = true
+	}
+
+	// the other tasks, which only get inverse relationships if they are known by the store
+	candidates := []quad.Value{}
+	for _, task := range tasks {
+		for _, targets := range taskRelationsByPredicate(*task) {
+			for _, target := range targets {
+				if !batch[target] {
+					candidates = append(candidates, target)
+				}
+			}
+		}
+		for _, q := range incoming[task.ID] {
+			if source, ok := q.Subject.(quad.IRI); ok && q.Label == nil && !batch[source] {
+				candidates = append(candidates, source)
+			}
+		}
+	}
+	known := map[quad.IRI]bool{}
+	if len(candidates) > 0 {
+		values, err := path.StartPath(h, candidates...).Has(quad.IRI("rdf:type"), quad.IRI("dv:Task")).Iterate(ctx).AllValues(h)
+		if err != nil {
+			return fmt.Errorf("load tasks: %w", err)
+		}
+		for _, id := range uniqueIRIs(values) {
+			known[id] = true
+		}
+	}
+	isTask := func(id quad.IRI) bool { return batch[id] || known[id] }
+
+	for _, task := range tasks {
+		// the inverses of the relationships declared by the task
+		wanted := map[quad.Quad]bool{}
+		for predicate, targets := range taskRelationsByPredicate(*task) {
+			for _, target := range targets {
+				if isTask(target) && target != task.ID {
+					wanted[quad.Make(target, inverseRelations[predicate], task.ID, task.ID)] = true
+				}
+			}
+		}
+		// inverse quads have the task as object and label, the label direction is not indexed by every backend
+		for _, old := range incoming[task.ID] {
+			if !IsInverseQuad(old) {
+				continue
+			}
+			if wanted[old] {
+				delete(wanted, old) // already stored
+				continue
+			}
+			tx.RemoveQuad(old)
+		}
+		adds := make([]quad.Quad, 0, len(wanted))
+		for q := range wanted {
+			adds = append(adds, q)
+		}
+
+		// the inverses of the relationships declared by stored tasks, which were saved before this one
+		for _, q := range incoming[task.ID] {
+			predicate, _ := q.Predicate.(quad.IRI)
+			source, ok := q.Subject.(quad.IRI)
+			if !ok || q.Label != nil || batch[source] || source == task.ID || !isTask(source) {
+				continue
+			}
+			if q := quad.Make(task.ID, inverseRelations[predicate], source, source); !existing[q] {
+				existing[q] = true
+				adds = append(adds, q)
+			}
+		}
+
+		sort.Slice(adds, func(i, j int) bool { return adds[i].NQuad() < adds[j].NQuad() })
+		for _, q := range adds {
+			tx.AddQuad(q)
+		}
+	}
+	return nil
+}
+
+// ExplicitRelations removes the materialised inverse relationships from tasks loaded from the store,
+// keeping the ones they declare themselves. The relationships of every task are loaded with a single query.
+func ExplicitRelations(ctx context.Context, h *cayley.Handle, tasks []*dvmodel.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	predicates := make([]quad.Value, 0, len(inverseRelations))
+	for predicate := range inverseRelations {
+		predicates = append(predicates, predicate)
+	}
+	ids := make([]quad.Value, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	quads, err := quadsWithAny(ctx, h, quad.Subject, ids, predicates...)
+	if err != nil {
+		return err
+	}
+	explicit := map[quad.IRI]map[quad.IRI]map[quad.Value]bool{}
+	for _, q := range quads {
+		subject, _ := q.Subject.(quad.IRI)
+		predicate, ok := q.Predicate.(quad.IRI)
+		if !ok || q.Label != nil {
+			continue
+		}
+		if explicit[subject] == nil {
+			explicit[subject] = map[quad.IRI]map[quad.Value]bool{}
+		}
+		if explicit[subject][predicate] == nil {
+			explicit[subject][predicate] = map[quad.Value]bool{}
+		}
+		explicit[subject][predicate][q.Object] = true
+	}
+	for _, task := range tasks {
+		relations := explicit[task.ID]
+		filter := func(predicate quad.IRI, iris []quad.IRI) []quad.IRI {
+			var kept []quad.IRI
+			for _, iri := range iris {
+				if relations[predicate][iri] {
+					kept = append(kept, iri)
+				}
+			}
+			return kept
+		}
+		task.IsDependingOn = filter("isDependingOn", task.IsDependingOn)
+		task.IsBlocking = filter("isBlocking", task.IsBlocking)
+		task.IsRelatedWith = filter("isRelatedWith", task.IsRelatedWith)
+		task.IsPartOf = filter("isPartOf", task.IsPartOf)
+		task.HasPart = filter("hasPart", task.HasPart)
+	}
+	return nil
+}
+
+// addInverses adds the inverse relationships between the tasks of all, like MaterializeInverses does in the store.
+func addInverses(all map[quad.IRI]dvmodel.Task) {
+	ids := make([]quad.IRI, 0, len(all))
+	for id := range all {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	inverses := map[quad.IRI]map[quad.IRI][]quad.IRI{}
+	for _, id := range ids {
+		for predicate, targets := range taskRelationsByPredicate(all[id]) {
+			for _, target := range targets {
+				if _, found := all[target]; !found || target == id {
+					continue
+				}
+				if inverses[target] == nil {
+					inverses[target] = map[quad.IRI][]quad.IRI{}
+				}
+				inverse := inverseRelations[predicate]
+				inverses[target][inverse] = append(inverses[target][inverse], id)
+			}
+		}
+	}
+	for id, relations := range inverses {
+		task := all[id]
+		merge := func(iris []quad.IRI, predicate quad.IRI) []quad.IRI {
+			for _, iri := range relations[predicate] {
+				if !containsIRI(iris, iri) {
+					iris = append(iris, iri)
+				}
+			}
+			return iris
+		}
+		task.IsDependingOn = merge(task.IsDependingOn, "isDependingOn")
+		task.IsBlocking = merge(task.IsBlocking, "isBlocking")
+		task.IsRelatedWith = merge(task.IsRelatedWith, "isRelatedWith")
+		task.IsPartOf = merge(task.IsPartOf, "isPartOf")
+		task.HasPart = merge(task.HasPart, "hasPart")
+		all[id] = task
+	}
+}
+
+func containsIRI(iris []quad.IRI, iri quad.IRI) bool {
+	for _, candidate := range iris {
+		if candidate == iri {
+			return true
+		}
+	}
+	return false
+}
+
+// quadsWith returns the quads having value in direction dir.
+func quadsWith(ctx context.Context, h *cayley.Handle, dir quad.Direction, value quad.Value) ([]quad.Quad, error) {
+	return quadsWithAny(ctx, h, dir, []quad.Value{value})
+}
+
+// quadsWithAny returns the quads having one of values in direction dir, and one of predicates if any, in a single query.
+func quadsWithAny(ctx context.Context, h *cayley.Handle, dir quad.Direction, values []quad.Value, predicates ...quad.Value) ([]quad.Quad, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	filters := shape.Quads{{Dir: dir, Values: shape.Lookup(values)}}
+	if len(predicates) > 0 {
+		filters = append(filters, shape.QuadFilter{Dir: quad.Predicate, Values: shape.Lookup(predicates)})
+	}
+	quads := []quad.Quad{}
+	it := shape.BuildIterator(h, filters)
+	defer it.Close()
+	for it.Next(ctx) {
+		q := h.Quad(it.Result())
+		if !q.IsValid() { // removed quads can still be iterated on some backends
+			continue
+		}
+		quads = append(quads, q)
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate quads: %w", err)
+	}
+	return quads, nil
+}
@@ -0,0 +1,288 @@
+package dvstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/quad"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/dvparser"
+	"moul.io/multipmuri/pmbodyparser"
+)
+
+// SchemaVersion is the version of the quads layout written by this version of depviz.
+//
+// It needs to be bumped, with a new entry in Migrations, every time a change
+// of the schema (predicates, types, etc) makes older stores load wrong data.
+const SchemaVersion = 2
+
+const (
+	// LegacySchemaVersion is the version reported for stores written before schema versioning.
+	LegacySchemaVersion = 1
+	// EmptySchemaVersion is the version reported for stores without any entity.
+	EmptySchemaVersion = 0
+)
+
+var (
+	schemaVersionSubject   = quad.IRI("dv:store")
+	schemaVersionPredicate = quad.IRI("dv:schemaVersion")
+
+	ErrSchemaOutdated = errors.New("store schema is outdated")
+	ErrSchemaTooNew   = errors.New("store schema is newer than supported")
+)
+
+// Migration upgrades a store from the previous version to Version.
+type Migration struct {
+	Version     int
+	Description string
+	Migrate     func(ctx context.Context, h *cayley.Handle, tx *graph.Transaction, logger *zap.Logger) error
+}
+
+// Migrations are applied in order, each one in its own transaction.
+var Migrations = []Migration{
+	{
+		Version:     2,
+		Description: "split Task.HasPart from Task.IsPartOf (both were written as isPartOf) and materialise the inverse relationships between tasks",
+		Migrate:     migrateRelationships,
+	},
+}
+
+// StoreSchemaVersion returns the schema version of the store, EmptySchemaVersion
+// for a store without entities and LegacySchemaVersion for an unversioned store.
+//
+// If multiple versions are found, i.e. after merging dumps, the oldest one is returned.
+func StoreSchemaVersion(ctx context.Context, h *cayley.Handle) (int, error) {
+	values, err := path.StartPath(h, schemaVersionSubject).Out(schemaVersionPredicate).Iterate(ctx).AllValues(h)
+	if err != nil {
+		return 0, fmt.Errorf("load schema version: %w", err)
+	}
+	version := 0
+	for _, value := range values {
+		typed, ok := value.(quad.Int)
+		if !ok {
+			return 0, fmt.Errorf("invalid schema version: %v", value)
+		}
+		if version == 0 || int(typed) < version {
+			version = int(typed)
+		}
+	}
+	if version > 0 {
+		return version, nil
+	}
+
+	// unversioned store
+	it := h.QuadIterator(quad.Predicate, h.ValueOf(quad.IRI("rdf:type")))
+	defer it.Close()
+	if it.Next(ctx) {
+		return LegacySchemaVersion, nil
+	}
+	return EmptySchemaVersion, it.Err()
+}
+
+// CheckSchemaVersion returns an error if the store needs to be migrated before being used.
+func CheckSchemaVersion(ctx context.Context, h *cayley.Handle) error {
+	version, err := StoreSchemaVersion(ctx, h)
+	if err != nil {
+		return err
+	}
+	switch {
+	case version == EmptySchemaVersion, version == SchemaVersion:
+		return nil
+	case version < SchemaVersion:
+		return fmt.Errorf("%w (v%d < v%d), run 'depviz store migrate'", ErrSchemaOutdated, version, SchemaVersion)
+	default:
+		return fmt.Errorf("%w (v%d > v%d), upgrade depviz", ErrSchemaTooNew, version, SchemaVersion)
+	}
+}
+
+// StampSchemaVersion adds the current schema version to tx if the store is still empty.
+func StampSchemaVersion(ctx context.Context, h *cayley.Handle, tx *graph.Transaction) error {
+	version, err := StoreSchemaVersion(ctx, h)
+	if err != nil {
+		return err
+	}
+	if version == EmptySchemaVersion {
+		setSchemaVersion(ctx, h, tx, SchemaVersion)
+	}
+	return nil
+}
+
+// PendingMigrations returns the migrations to apply to a store at version.
+func PendingMigrations(version int) []Migration {
+	pending := []Migration{}
+	for _, migration := range Migrations {
+		if migration.Version > version {
+			pending = append(pending, migration)
+		}
+	}
+	return pending
+}
+
+// Migrate applies every pending migration and returns the versions before and after.
+func Migrate(ctx context.Context, h *cayley.Handle, logger *zap.Logger) (int, int, error) {
+	from, err := StoreSchemaVersion(ctx, h)
+	if err != nil {
+		return 0, 0, err
+	}
+	if from == EmptySchemaVersion {
+		tx := cayley.NewTransaction()
+		setSchemaVersion(ctx, h, tx, SchemaVersion)
+		if err := h.ApplyTransaction(tx); err != nil {
+			return from, from, fmt.Errorf("apply tx: %w", err)
+		}
+		return from, SchemaVersion, nil
+	}
+	if from > SchemaVersion {
+		return from, from, fmt.Errorf("%w (v%d > v%d)", ErrSchemaTooNew, from, SchemaVersion)
+	}
+
+	current := from
+	for _, migration := range PendingMigrations(from) {
+		logger.Info("migrate store", zap.Int("from", current), zap.Int("to", migration.Version), zap.String("description", migration.Description))
+		tx := cayley.NewTransaction()
+		if err := migration.Migrate(ctx, h, tx, logger); err != nil {
+			return from, current, fmt.Errorf("migration v%d: %w", migration.Version, err)
+		}
+		setSchemaVersion(ctx, h, tx, migration.Version)
+		if err := applyTransaction(ctx, h, tx); err != nil {
+			return from, current, fmt.Errorf("migration v%d: apply tx: %w", migration.Version, err)
+		}
+		current = migration.Version
+	}
+	return from, current, nil
+}
+
+func setSchemaVersion(ctx context.Context, h *cayley.Handle, tx *graph.Transaction, version int) {
+	values, _ := path.StartPath(h, schemaVersionSubject).Out(schemaVersionPredicate).Iterate(ctx).AllValues(h)
+	for _, value := range values {
+		tx.RemoveQuad(quad.Make(schemaVersionSubject, schemaVersionPredicate, value, nil))
+	}
+	tx.AddQuad(quad.Make(schemaVersionSubject, schemaVersionPredicate, quad.Int(version), nil))
+}
+
+// migrateRelationships recomputes isPartOf and hasPart relationships from the task descriptions,
+// because stores written with v1 mixed both under the isPartOf predicate, then materialises
+// the inverse relationships of the tasks, which were not maintained either.
+func migrateRelationships(ctx context.Context, h *cayley.Handle, tx *graph.Transaction, logger *zap.Logger) error {
+	subjects, err := path.StartPath(h).Has(quad.IRI("rdf:type"), quad.IRI("dv:Task")).Iterate(ctx).AllValues(h)
+	if err != nil {
+		return fmt.Errorf("load tasks: %w", err)
+	}
+	if len(subjects) == 0 {
+		return nil
+	}
+	predicates := make([]quad.Value, 0, len(inverseRelations))
+	for predicate := range inverseRelations {
+		predicates = append(predicates, predicate)
+	}
+	quads, err := quadsWithAny(ctx, h, quad.Subject, subjects, predicates...)
+	if err != nil {
+		return err
+	}
+	tasks := map[quad.IRI]*dvmodel.Task{}
+	for _, subject := range subjects {
+		if id, ok := subject.(quad.IRI); ok {
+			tasks[id] = &dvmodel.Task{ID: id}
+		}
+	}
+	for _, q := range quads {
+		subject, _ := q.Subject.(quad.IRI)
+		object, ok := q.Object.(quad.IRI)
+		task := tasks[subject]
+		if !ok || task == nil || q.Label != nil {
+			continue
+		}
+		switch q.Predicate {
+		case quad.IRI("isDependingOn"):
+			task.IsDependingOn = append(task.IsDependingOn, object)
+		case quad.IRI("isBlocking"):
+			task.IsBlocking = append(task.IsBlocking, object)
+		case quad.IRI("isRelatedWith"):
+			task.IsRelatedWith = append(task.IsRelatedWith, object)
+		case quad.IRI("isPartOf"):
+			task.IsPartOf = append(task.IsPartOf, object)
+		case quad.IRI("hasPart"):
+			task.HasPart = append(task.HasPart, object)
+		}
+	}
+
+	if err := splitHasPart(ctx, h, tx, tasks, logger); err != nil {
+		return err
+	}
+
+	ordered := make([]*dvmodel.Task, 0, len(tasks))
+	for _, id := range uniqueIRIs(subjects) {
+		ordered = append(ordered, tasks[id])
+	}
+	if err := MaterializeInverses(ctx, h, tx, ordered); err != nil {
+		return err
+	}
+	logger.Debug("materialised inverse relationships", zap.Int("tasks", len(ordered)))
+	return nil
+}
+
+// splitHasPart rewrites the isPartOf relationships of tasks from their descriptions, tasks are updated in place.
+func splitHasPart(ctx context.Context, h *cayley.Handle, tx *graph.Transaction, tasks map[quad.IRI]*dvmodel.Task, logger *zap.Logger) error {
+	var (
+		isPartOf    = quad.IRI("isPartOf")
+		hasPart     = quad.IRI("hasPart")
+		description = quad.IRI("schema:description")
+	)
+
+	ids := []quad.Value{}
+	for id, task := range tasks {
+		if len(task.IsPartOf) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	quads, err := quadsWithAny(ctx, h, quad.Subject, ids, description)
+	if err != nil {
+		return fmt.Errorf("load descriptions: %w", err)
+	}
+	descriptions := map[quad.IRI]string{}
+	for _, q := range quads {
+		subject, _ := q.Subject.(quad.IRI)
+		if _, ok := descriptions[subject]; !ok {
+			descriptions[subject], _ = quad.NativeOf(q.Object).(string)
+		}
+	}
+
+	for _, id := range uniqueIRIs(ids) {
+		task := tasks[id]
+		entity, err := dvparser.ParseTarget(string(id))
+		if err != nil {
+			logger.Warn("cannot parse task ID, skipping", zap.String("id", string(id)), zap.Error(err))
+			continue
+		}
+		relationships, errs := pmbodyparser.RelParseString(entity, descriptions[id])
+		if len(errs) > 0 {
+			logger.Warn("cannot parse task description, skipping", zap.String("id", string(id)), zap.Errors("errs", errs))
+			continue
+		}
+
+		for _, old := range task.IsPartOf {
+			tx.RemoveQuad(quad.Make(id, isPartOf, old, nil))
+		}
+		task.IsPartOf = nil
+		for _, relationship := range relationships {
+			target := quad.IRI(relationship.Target.String())
+			switch relationship.Kind { // nolint:exhaustive
+			case pmbodyparser.PartOf:
+				task.IsPartOf = append(task.IsPartOf, target)
+				tx.AddQuad(quad.Make(id, isPartOf, target, nil))
+			case pmbodyparser.ParentOf:
+				task.HasPart = append(task.HasPart, target)
+				tx.AddQuad(quad.Make(id, hasPart, target, nil))
+			}
+		}
+	}
+	return nil
+}
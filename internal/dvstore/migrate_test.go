@@ -0,0 +1,142 @@
+package dvstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestSchemaVersionEmptyStore(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingStore(t)
+	defer close()
+
+	version, err := StoreSchemaVersion(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, EmptySchemaVersion, version)
+	assert.NoError(t, CheckSchemaVersion(ctx, store))
+
+	from, to, err := Migrate(ctx, store, logger)
+	require.NoError(t, err)
+	assert.Equal(t, EmptySchemaVersion, from)
+	assert.Equal(t, SchemaVersion, to)
+
+	version, err = StoreSchemaVersion(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, version)
+}
+
+func TestSchemaVersionLegacyStore(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	version, err := StoreSchemaVersion(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, LegacySchemaVersion, version)
+	err = CheckSchemaVersion(ctx, store)
+	assert.True(t, errors.Is(err, ErrSchemaOutdated))
+	assert.Len(t, PendingMigrations(version), len(Migrations))
+
+	from, to, err := Migrate(ctx, store, logger)
+	require.NoError(t, err)
+	assert.Equal(t, LegacySchemaVersion, from)
+	assert.Equal(t, SchemaVersion, to)
+	assert.NoError(t, CheckSchemaVersion(ctx, store))
+	assert.Len(t, PendingMigrations(to), 0)
+
+	// idempotent
+	from, to, err = Migrate(ctx, store, logger)
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, from)
+	assert.Equal(t, SchemaVersion, to)
+
+	tasks, err := LoadTasks(store, schemaConfig, LoadTasksFilters{TheWorld: true}, logger)
+	require.NoError(t, err)
+	assert.NotEmpty(t, tasks)
+
+	// inverse relationships are materialised
+	var task dvmodel.Task
+	require.NoError(t, schemaConfig.LoadTo(ctx, store, &task, quad.IRI("https://github.com/moul/depviz-test/issues/4")))
+	assert.ElementsMatch(t, []quad.IRI{"https://github.com/moul/depviz-test/issues/5", "https://github.com/moul/depviz-test/issues/10"}, task.IsBlocking)
+}
+
+func TestSchemaVersionTooNew(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingStore(t)
+	defer close()
+
+	require.NoError(t, store.AddQuad(quad.Make(schemaVersionSubject, schemaVersionPredicate, quad.Int(SchemaVersion+1), nil)))
+	err := CheckSchemaVersion(ctx, store)
+	assert.True(t, errors.Is(err, ErrSchemaTooNew))
+
+	// merged stores report their oldest version
+	require.NoError(t, store.AddQuad(quad.Make(schemaVersionSubject, schemaVersionPredicate, quad.Int(LegacySchemaVersion), nil)))
+	version, err := StoreSchemaVersion(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, LegacySchemaVersion, version)
+}
+
+func TestMigrateRelationships(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingStore(t)
+	defer close()
+
+	var (
+		parent  = quad.IRI("https://github.com/moul/depviz-test/issues/1")
+		child   = quad.IRI("https://github.com/moul/depviz-test/issues/2")
+		epic    = quad.IRI("https://github.com/moul/depviz-test/issues/3")
+		unknown = quad.IRI("https://github.com/moul/depviz-test/issues/4")
+	)
+	// v1 wrote both IsPartOf and HasPart as isPartOf
+	legacy := []quad.Quad{
+		quad.Make(parent, quad.IRI("rdf:type"), quad.IRI("dv:Task"), nil),
+		quad.Make(parent, quad.IRI("schema:kind"), quad.Int(dvmodel.Task_Issue), nil),
+		quad.Make(parent, quad.IRI("schema:description"), quad.String("part of #3\nparent of #2"), nil),
+		quad.Make(parent, quad.IRI("isPartOf"), epic, nil),
+		quad.Make(parent, quad.IRI("isPartOf"), child, nil),
+		quad.Make(epic, quad.IRI("rdf:type"), quad.IRI("dv:Task"), nil),
+		quad.Make(unknown, quad.IRI("rdf:type"), quad.IRI("dv:Task"), nil),
+	}
+	require.NoError(t, store.AddQuadSet(legacy))
+
+	_, to, err := Migrate(ctx, store, logger)
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, to)
+
+	var task dvmodel.Task
+	require.NoError(t, schemaConfig.LoadTo(ctx, store, &task, parent))
+	assert.Equal(t, []quad.IRI{epic}, task.IsPartOf)
+	assert.Equal(t, []quad.IRI{child}, task.HasPart)
+	assert.Equal(t, 1, countPredicate(t, store, "isPartOf"))
+
+	// the split relationships are materialised on the tasks known by the store, child is not one
+	var inverse dvmodel.Task
+	require.NoError(t, schemaConfig.LoadTo(ctx, store, &inverse, epic))
+	assert.Equal(t, []quad.IRI{parent}, inverse.HasPart)
+	quads, err := quadsWith(ctx, store, quad.Subject, epic)
+	require.NoError(t, err)
+	assert.Contains(t, quads, quad.Make(epic, quad.IRI("hasPart"), parent, parent))
+	assert.Equal(t, 2, countPredicate(t, store, "hasPart"))
+}
+
+func countPredicate(t *testing.T, h *cayley.Handle, predicate quad.IRI) int {
+	t.Helper()
+	it := h.QuadIterator(quad.Predicate, h.ValueOf(predicate))
+	defer it.Close()
+	count := 0
+	for it.Next(context.Background()) {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,256 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/multipmuri"
+)
+
+// PruneOpts configures the retention policies applied by Prune, a zero value disables every policy.
+type PruneOpts struct {
+	// ClosedOlderThan drops the closed tasks without activity for that long,
+	// unless they are connected to an open task, in either direction (e.g. blocking it).
+	ClosedOlderThan time.Duration
+	// UnconfiguredTargets drops the repos (and their tasks) not matching one of Targets.
+	UnconfiguredTargets bool
+	Targets             []multipmuri.Entity
+	// Orphans drops the owners and topics not referenced by any other entity
+	// and never synced directly.
+	Orphans bool
+	// DryRun computes the report without touching the store.
+	DryRun bool
+	// Now defaults to time.Now().
+	Now time.Time
+}
+
+// PruneReport lists the entities dropped by Prune.
+type PruneReport struct {
+	ClosedTasks       []quad.IRI
+	UnconfiguredRepos []quad.IRI
+	UnconfiguredTasks []quad.IRI
+	Orphans           []quad.IRI
+	// Quads is the number of quads removed, including the relationships pointing at dropped entities.
+	Quads int
+}
+
+// Entities returns the number of dropped entities.
+func (r PruneReport) Entities() int {
+	return len(r.ClosedTasks) + len(r.UnconfiguredRepos) + len(r.UnconfiguredTasks) + len(r.Orphans)
+}
+
+// Prune applies the retention policies and removes the dropped entities in a single transaction.
+func Prune(ctx context.Context, h *cayley.Handle, opts PruneOpts) (*PruneReport, error) {
+	if opts.UnconfiguredTargets && len(opts.Targets) == 0 {
+		return nil, fmt.Errorf("pruning unconfigured targets requires at least one target")
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var (
+		quads      = []quad.Quad{}
+		types      = map[quad.IRI]quad.IRI{}
+		kinds      = map[quad.IRI]int64{}
+		states     = map[quad.IRI]int64{}
+		activity   = map[quad.IRI]time.Time{}
+		owners     = map[quad.IRI]quad.IRI{}
+		synced     = map[quad.IRI]bool{}
+		references = map[quad.IRI][]quad.IRI{}
+		referrers  = map[quad.IRI][]quad.IRI{}
+	)
+	it := h.QuadsAllIterator()
+	defer it.Close()
+	for it.Next(ctx) {
+		q := h.Quad(it.Result())
+		if !q.IsValid() {
+			continue
+		}
+		quads = append(quads, q)
+		subject, ok := q.Subject.(quad.IRI)
+		if !ok {
+			continue
+		}
+		switch predicate, _ := q.Predicate.(quad.IRI); predicate {
+		case "rdf:type":
+			types[subject], _ = q.Object.(quad.IRI)
+		case "schema:kind":
+			if kind, ok := q.Object.(quad.Int); ok {
+				kinds[subject] = int64(kind)
+			}
+		case "schema:state":
+			if state, ok := q.Object.(quad.Int); ok {
+				states[subject] = int64(state)
+			}
+		case "schema:updatedAt", "schema:completedAt":
+			if at, ok := quad.NativeOf(q.Object).(time.Time); ok && at.After(activity[subject]) {
+				activity[subject] = at
+			}
+		case LastSyncedAtPredicate:
+			synced[subject] = true
+		default:
+			object, ok := q.Object.(quad.IRI)
+			if !ok {
+				break
+			}
+			if predicate == "hasOwner" {
+				owners[subject] = object
+			}
+			references[subject] = append(references[subject], object)
+			if _, ok := inverseRelations[predicate]; ok {
+				referrers[object] = append(referrers[object], subject)
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate quads: %w", err)
+	}
+
+	report := PruneReport{}
+	dropped := map[quad.IRI]bool{}
+	ids := sortedIRIs(types)
+	isTask := func(id quad.IRI) bool { return types[id] == "dv:Task" }
+
+	// reachable returns the tasks that can be reached from roots, following any relationship, and the relationships
+	// between tasks in both directions: a task blocking a kept task is also kept, as if it was materialised.
+	reachable := func(roots func(id quad.IRI) bool) map[quad.IRI]bool {
+		seen := map[quad.IRI]bool{}
+		queue := []quad.IRI{}
+		for _, id := range ids {
+			if isTask(id) && !dropped[id] && roots(id) {
+				seen[id] = true
+				queue = append(queue, id)
+			}
+		}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for _, refs := range [][]quad.IRI{references[id], referrers[id]} {
+				for _, ref := range refs {
+					if !seen[ref] && isTask(ref) {
+						seen[ref] = true
+						queue = append(queue, ref)
+					}
+				}
+			}
+		}
+		return seen
+	}
+
+	if opts.UnconfiguredTargets {
+		configured := map[quad.IRI]bool{}
+		for _, target := range opts.Targets {
+			configured[quad.IRI(target.String())] = true
+			if withRepo, ok := target.(interface{ Repo() *multipmuri.GitHubRepo }); ok {
+				configured[quad.IRI(withRepo.Repo().String())] = true
+			}
+		}
+		unconfigured := map[quad.IRI]bool{}
+		for _, id := range ids {
+			if types[id] == "dv:Owner" && kinds[id] == int64(dvmodel.Owner_Repo) && !configured[id] && !configured[owners[id]] {
+				unconfigured[id] = true
+			}
+		}
+
+		// tasks of unconfigured repos are kept while they are related to a task of a configured repo
+		kept := reachable(func(id quad.IRI) bool { return !unconfigured[owners[id]] })
+		for _, id := range ids {
+			if isTask(id) && unconfigured[owners[id]] {
+				if kept[id] {
+					delete(unconfigured, owners[id])
+				} else {
+					dropped[id] = true
+					report.UnconfiguredTasks = append(report.UnconfiguredTasks, id)
+				}
+			}
+		}
+		for _, id := range ids {
+			if unconfigured[id] {
+				dropped[id] = true
+				report.UnconfiguredRepos = append(report.UnconfiguredRepos, id)
+			}
+		}
+	}
+
+	if opts.ClosedOlderThan > 0 {
+		kept := reachable(func(id quad.IRI) bool { return states[id] != int64(dvmodel.Task_Closed) })
+		before := opts.Now.Add(-opts.ClosedOlderThan)
+		for _, id := range ids {
+			if !isTask(id) || dropped[id] || kept[id] {
+				continue
+			}
+			if activity[id].Before(before) {
+				dropped[id] = true
+				report.ClosedTasks = append(report.ClosedTasks, id)
+			}
+		}
+	}
+
+	if opts.Orphans {
+		// dropping an entity can orphan its owner, loop until nothing changes
+		for {
+			referenced := map[quad.IRI]bool{}
+			for subject, refs := range references {
+				if dropped[subject] {
+					continue
+				}
+				for _, ref := range refs {
+					if ref != subject {
+						referenced[ref] = true
+					}
+				}
+			}
+			orphans := []quad.IRI{}
+			for _, id := range ids {
+				kind := types[id]
+				if (kind == "dv:Owner" || kind == "dv:Topic") && !dropped[id] && !referenced[id] && !synced[id] {
+					orphans = append(orphans, id)
+				}
+			}
+			if len(orphans) == 0 {
+				break
+			}
+			for _, id := range orphans {
+				dropped[id] = true
+			}
+			report.Orphans = append(report.Orphans, orphans...)
+		}
+		sort.Slice(report.Orphans, func(i, j int) bool { return report.Orphans[i] < report.Orphans[j] })
+	}
+
+	if len(dropped) == 0 {
+		return &report, nil
+	}
+	// the inverse relationships materialised from a dropped task are labelled with it, see MaterializeInverses
+	tx := cayley.NewTransaction()
+	for _, q := range quads {
+		subject, _ := q.Subject.(quad.IRI)
+		object, _ := q.Object.(quad.IRI)
+		label, _ := q.Label.(quad.IRI)
+		if dropped[subject] || dropped[object] || dropped[label] {
+			tx.RemoveQuad(q)
+			report.Quads++
+		}
+	}
+	if opts.DryRun {
+		return &report, nil
+	}
+	if err := h.ApplyTransaction(tx); err != nil {
+		return nil, fmt.Errorf("apply tx: %w", err)
+	}
+	return &report, nil
+}
+
+func sortedIRIs(m map[quad.IRI]quad.IRI) []quad.IRI {
+	keys := make([]quad.IRI, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,144 @@
+package dvstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+func TestPruneUnconfiguredTargets(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	before := countAllQuads(t, store)
+	opts := PruneOpts{
+		UnconfiguredTargets: true,
+		Targets:             parseTargets(t, "moul-bot/depviz-test"),
+		Orphans:             true,
+		DryRun:              true,
+	}
+	report, err := Prune(ctx, store, opts)
+	require.NoError(t, err)
+	// moul/depviz-test is kept because its tasks are related to the ones of moul-bot/depviz-test,
+	// only the isolated one is dropped
+	assert.Empty(t, report.UnconfiguredRepos)
+	assert.Equal(t, []quad.IRI{"https://github.com/moul/depviz-test/issues/1"}, report.UnconfiguredTasks)
+	assert.Empty(t, report.Orphans)
+	assert.Equal(t, before, countAllQuads(t, store))
+
+	opts.DryRun = false
+	report, err = Prune(ctx, store, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Entities())
+	assert.Equal(t, before-report.Quads, countAllQuads(t, store))
+
+	problems, err := Diagnose(ctx, store)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	_, err = Prune(ctx, store, PruneOpts{UnconfiguredTargets: true})
+	assert.Error(t, err)
+}
+
+func TestPruneClosedTasksAndOrphans(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingStore(t)
+	defer close()
+
+	var (
+		now    = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		old    = now.Add(-365 * 24 * time.Hour)
+		recent = now.Add(-24 * time.Hour)
+		org    = quad.IRI("https://github.com/moul")
+		repo   = quad.IRI("https://github.com/moul/depviz-test")
+		author = quad.IRI("https://github.com/moul-bot")
+		open   = quad.IRI("https://github.com/moul/depviz-test/issues/1")
+		dep    = quad.IRI("https://github.com/moul/depviz-test/issues/2") // closed, old, reachable from open
+		stale  = quad.IRI("https://github.com/moul/depviz-test/issues/3") // closed, old
+		fresh  = quad.IRI("https://github.com/moul/depviz-test/issues/4") // closed, recent
+		stuck  = quad.IRI("https://github.com/moul/depviz-test/issues/5") // closed, old, blocking open
+	)
+	quads := []quad.Quad{
+		quad.Make(org, quad.IRI("rdf:type"), quad.IRI("dv:Owner"), nil),
+		quad.Make(org, quad.IRI("schema:kind"), quad.Int(dvmodel.Owner_Organization), nil),
+		quad.Make(repo, quad.IRI("rdf:type"), quad.IRI("dv:Owner"), nil),
+		quad.Make(repo, quad.IRI("schema:kind"), quad.Int(dvmodel.Owner_Repo), nil),
+		quad.Make(repo, quad.IRI("hasOwner"), org, nil),
+		quad.Make(repo, LastSyncedAtPredicate, quad.Time(now), nil),
+		quad.Make(author, quad.IRI("rdf:type"), quad.IRI("dv:Owner"), nil),
+		quad.Make(author, quad.IRI("schema:kind"), quad.Int(dvmodel.Owner_User), nil),
+	}
+	for _, task := range []struct {
+		id      quad.IRI
+		state   dvmodel.Task_State
+		updated time.Time
+	}{{open, dvmodel.Task_Open, old}, {dep, dvmodel.Task_Closed, old}, {stale, dvmodel.Task_Closed, old}, {fresh, dvmodel.Task_Closed, recent}, {stuck, dvmodel.Task_Closed, old}} {
+		quads = append(quads,
+			quad.Make(task.id, quad.IRI("rdf:type"), quad.IRI("dv:Task"), nil),
+			quad.Make(task.id, quad.IRI("schema:state"), quad.Int(task.state), nil),
+			quad.Make(task.id, quad.IRI("schema:updatedAt"), quad.Time(task.updated), nil),
+			quad.Make(task.id, quad.IRI("hasOwner"), repo, nil),
+		)
+	}
+	quads = append(quads,
+		quad.Make(open, quad.IRI("isDependingOn"), dep, nil),
+		quad.Make(stale, quad.IRI("hasAuthor"), author, nil),
+		quad.Make(fresh, quad.IRI("isRelatedWith"), stale, nil),
+		quad.Make(stuck, quad.IRI("isBlocking"), open, nil),
+		quad.Make(stale, quad.IRI("isDependingOn"), fresh, nil),
+		// inverse relationships, see MaterializeInverses
+		quad.Make(dep, quad.IRI("isBlocking"), open, open),
+		quad.Make(stale, quad.IRI("isRelatedWith"), fresh, fresh),
+		quad.Make(open, quad.IRI("isDependingOn"), stuck, stuck),
+		quad.Make(fresh, quad.IRI("isBlocking"), stale, stale),
+	)
+	require.NoError(t, store.AddQuadSet(quads))
+
+	report, err := Prune(ctx, store, PruneOpts{ClosedOlderThan: 30 * 24 * time.Hour, Orphans: true, Now: now})
+	require.NoError(t, err)
+	assert.Equal(t, []quad.IRI{stale}, report.ClosedTasks)
+	// the author was only referenced by the dropped task, the org is still referenced by the synced repo
+	assert.Equal(t, []quad.IRI{author}, report.Orphans)
+	// the inverse relationships of the dropped task are removed with it
+	it := store.QuadsAllIterator()
+	for it.Next(ctx) {
+		q := store.Quad(it.Result())
+		for _, dir := range quad.Directions {
+			assert.NotEqual(t, stale, q.Get(dir), q.NQuad())
+		}
+	}
+	require.NoError(t, it.Close())
+
+	problems, err := Diagnose(ctx, store)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// once the repo is not configured anymore, the whole owner chain goes away
+	report, err = Prune(ctx, store, PruneOpts{ClosedOlderThan: time.Hour, UnconfiguredTargets: true, Targets: parseTargets(t, "moul-bot/depviz-test"), Orphans: true, Now: now})
+	require.NoError(t, err)
+	assert.Equal(t, []quad.IRI{repo}, report.UnconfiguredRepos)
+	assert.Len(t, report.UnconfiguredTasks, 4)
+	assert.Equal(t, []quad.IRI{org}, report.Orphans)
+	assert.Equal(t, 0, countAllQuads(t, store))
+}
+
+func countAllQuads(t *testing.T, h *cayley.Handle) int {
+	t.Helper()
+	it := h.QuadsAllIterator()
+	defer it.Close()
+	count := 0
+	for it.Next(context.Background()) {
+		if h.Quad(it.Result()).IsValid() {
+			count++
+		}
+	}
+	require.NoError(t, it.Err())
+	return count
+}
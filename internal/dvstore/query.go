@@ -52,6 +52,9 @@ func LastUpdatedIssueInRepo(ctx context.Context, h *cayley.Handle, entity multip
 	return since, nil
 }
 
+// DefaultDepsDepth is the number of dependency hops followed when LoadTasksFilters.DepsDepth is unset.
+const DefaultDepsDepth = 1
+
 type LoadTasksFilters struct {
 	Targets             []multipmuri.Entity
 	TheWorld            bool
@@ -60,27 +63,249 @@ type LoadTasksFilters struct {
 	WithoutPRs          bool
 	WithoutExternalDeps bool
 	WithFetch           bool
+	// DepsDirection and DepsDepth configure how the relationships of the selected tasks are followed,
+	// unless WithoutExternalDeps is set. DepsDepth is the number of hops, DefaultDepsDepth if unset,
+	// a negative depth follows the full transitive closure.
+	DepsDirection DepsDirection `json:",omitempty"`
+	DepsDepth     int           `json:",omitempty"`
+	// Filter restricts the selected tasks, before following their relationships, see ParseTaskFilter.
+	Filter *TaskFilter `json:",omitempty"`
+	// AsOf, if set, loads the tasks as they were at that time, from the snapshots.
+	AsOf *time.Time `json:",omitempty"`
+	// After is a cursor, only the tasks with a greater ID are loaded (tasks are sorted by ID).
+	After quad.IRI `json:",omitempty"`
+	// Limit is the maximum number of tasks to load, 0 means unlimited.
+	Limit int `json:",omitempty"`
+	// Index, if set, is used to select and traverse the current tasks in memory, see NewIndex.
+	Index *Index `json:"-"`
 }
 
-func LoadTasks(h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters, logger *zap.Logger) (dvmodel.Tasks, error) {
+// depsDepth returns the number of dependency hops to follow, see DepsDepth.
+func (filters LoadTasksFilters) depsDepth() int {
+	if filters.DepsDepth == 0 {
+		return DefaultDepsDepth
+	}
+	return filters.DepsDepth
+}
+
+// TasksPage is a page of tasks, see LoadTasksPage.
+type TasksPage struct {
+	Tasks dvmodel.Tasks
+	// Truncated is true if more tasks match the filters, NextCursor is then the After of the next page.
+	Truncated  bool
+	NextCursor quad.IRI
+}
+
+// taskRelationPredicates are the dependency-like relationships between tasks, see dvmodel.Task.AllRelations.
+var taskRelationPredicates = []interface{}{
+	quad.IRI("isDependingOn"),
+	quad.IRI("isBlocking"),
+	quad.IRI("isRelatedWith"),
+	quad.IRI("isPartOf"),
+	quad.IRI("hasPart"),
+}
+
+// taskLoadChunk is the number of tasks loaded per query by TaskIterator.
+const taskLoadChunk = 100
+
+// TaskIterator streams the tasks matching some filters, sorted by ID.
+//
+// Only the IDs are resolved upfront, the tasks are loaded in chunks while iterating.
+type TaskIterator struct {
+	ctx     context.Context
+	h       *cayley.Handle
+	schema  *schema.Config
+	limit   int
+	aliases Aliases
+
+	ids    []quad.IRI
+	merges map[quad.IRI][]quad.IRI // the aliases of ids, merged into them while loading them
+	pos    int
+	buffer dvmodel.Tasks
+	task   dvmodel.Task
+	count  int
+	err    error
+}
+
+// IterateTasks returns an iterator over the tasks matching filters.
+func IterateTasks(h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters, logger *zap.Logger) (*TaskIterator, error) {
 	if (filters.Targets == nil || len(filters.Targets) == 0) && !filters.TheWorld {
 		return nil, fmt.Errorf("missing filter.targets")
 	}
 
 	ctx := context.TODO()
+	aliases, err := LoadAliases(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	// targeting an entity targets its aliases too
+	filters.Targets = aliases.expandTargets(filters.Targets)
+	it := TaskIterator{ctx: ctx, h: h, schema: schema, limit: filters.Limit, aliases: aliases}
+
+	if filters.AsOf != nil || filters.Index != nil {
+		var tasks dvmodel.Tasks
+		if filters.AsOf != nil {
+			tasks, err = loadTasksAsOf(ctx, h, schema, filters, aliases, logger)
+		} else {
+			tasks, err = filters.Index.loadTasks(ctx, h, filters, aliases)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range aliases.mergeTasks(tasks) {
+			if task.ID > filters.After {
+				it.buffer = append(it.buffer, task)
+			}
+		}
+		return &it, nil
+	}
+
+	ids, err := taskIDs(ctx, h, schema, filters, aliases)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[quad.IRI]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	it.merges = map[quad.IRI][]quad.IRI{}
+	for _, id := range ids {
+		// the aliases of a task are merged into it, see Aliases.mergeTasks
+		switch {
+		case aliases.merged(id, set):
+			canonical := aliases.Canonical(id)
+			it.merges[canonical] = append(it.merges[canonical], id)
+		case id > filters.After:
+			it.ids = append(it.ids, id)
+		}
+	}
+	return &it, nil
+}
+
+// Next loads the next task, it returns false at the end of the iteration or on error.
+func (it *TaskIterator) Next() bool {
+	if it.err != nil || (it.limit > 0 && it.count >= it.limit) {
+		return false
+	}
+	if !it.fill() {
+		return false
+	}
+	it.task, it.buffer = it.aliases.canonicalTask(it.buffer[0]), it.buffer[1:]
+	it.count++
+	return true
+}
+
+// Task returns the current task.
+func (it *TaskIterator) Task() dvmodel.Task { return it.task }
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TaskIterator) Err() error { return it.err }
+
+// Truncated returns true if the iteration stopped because of the limit while more tasks were matching.
+func (it *TaskIterator) Truncated() bool {
+	if it.err != nil || it.limit == 0 || it.count < it.limit {
+		return false
+	}
+	return it.fill()
+}
+
+// fill loads the next chunk of tasks if the buffer is empty, it returns false if there is no task left.
+func (it *TaskIterator) fill() bool {
+	for len(it.buffer) == 0 && it.pos < len(it.ids) {
+		end := it.pos + taskLoadChunk
+		if end > len(it.ids) {
+			end = len(it.ids)
+		}
+		values := make([]quad.Value, 0, end-it.pos)
+		for _, id := range it.ids[it.pos:end] {
+			values = append(values, id)
+			for _, alias := range it.merges[id] {
+				values = append(values, alias)
+			}
+		}
+		it.pos = end
+
+		tasks := dvmodel.Tasks{}
+		if err := it.schema.LoadPathTo(it.ctx, it.h, &tasks, path.StartPath(it.h, values...)); err != nil {
+			it.err = fmt.Errorf("load tasks: %w", err)
+			return false
+		}
+		tasks = it.aliases.mergeTasks(tasks)
+		sort.Slice(tasks, func(i, j int) bool {
+			return tasks[i].ID < tasks[j].ID
+		})
+		it.buffer = tasks
+	}
+	return len(it.buffer) > 0
+}
 
+// LoadTasksPage loads the tasks matching filters, up to filters.Limit.
+func LoadTasksPage(h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters, logger *zap.Logger) (*TasksPage, error) {
+	it, err := IterateTasks(h, schema, filters, logger)
+	if err != nil {
+		return nil, err
+	}
+	page := TasksPage{Tasks: dvmodel.Tasks{}}
+	for it.Next() {
+		page.Tasks = append(page.Tasks, it.Task())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	if it.Truncated() {
+		page.Truncated = true
+		page.NextCursor = page.Tasks[len(page.Tasks)-1].ID
+	}
+	return &page, it.Err()
+}
+
+// LoadTasks loads the tasks matching filters, sorted by ID.
+//
+// A warning is logged if they are truncated by filters.Limit, use LoadTasksPage to paginate.
+func LoadTasks(h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters, logger *zap.Logger) (dvmodel.Tasks, error) {
+	page, err := LoadTasksPage(h, schema, filters, logger)
+	if err != nil {
+		return nil, err
+	}
+	if page.Truncated {
+		logger.Warn("tasks truncated", zap.Int("limit", filters.Limit), zap.String("next-cursor", string(page.NextCursor)))
+	}
+	return page.Tasks, nil
+}
+
+// LoadCycles returns the dependency cycles between the tasks matching filters, see dvmodel.Tasks.Cycles.
+//
+// The pagination (filters.After and filters.Limit) is ignored, as the cycles of a page can go through the tasks of
+// other pages, and only the dependencies of the tasks are kept in memory.
+func LoadCycles(h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters, logger *zap.Logger) ([]*dvmodel.Cycle, error) {
+	filters.After, filters.Limit = "", 0
+	it, err := IterateTasks(h, schema, filters, logger)
+	if err != nil {
+		return nil, err
+	}
+	deps := dvmodel.Tasks{}
+	for it.Next() {
+		task := it.Task()
+		deps = append(deps, dvmodel.Task{ID: task.ID, IsDependingOn: task.IsDependingOn, IsBlocking: task.IsBlocking})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return deps.Cycles(), nil
+}
+
+// taskIDs returns the sorted IDs of the tasks matching filters.
+func taskIDs(ctx context.Context, h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters, aliases Aliases) ([]quad.IRI, error) {
 	// fetch targets
 	paths := []*path.Path{}
 	if filters.TheWorld {
 		paths = append(paths, path.StartPath(h))
 	} else {
 		for _, target := range filters.Targets {
-			// FIXME: handle different target types (for now only repo)
-			p := path.StartPath(h, quad.IRI(target.String())).
-				Both().
-				Has(quad.IRI("rdf:type"), quad.IRI("dv:Task"))
-
-			// FIXME: reverse depends/blocks
+			p, err := targetPath(ctx, h, target)
+			if err != nil {
+				return nil, fmt.Errorf("resolve target %q: %w", target.String(), err)
+			}
 			paths = append(paths, p)
 		}
 	}
@@ -105,46 +330,94 @@ func LoadTasks(h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters
 		p = p.Has(quad.IRI("schema:state"), quad.Int(dvmodel.Task_Open))
 	}
 
-	if !filters.WithoutExternalDeps {
-		p = p.Or(p.Both(
-			quad.IRI("isDependingOn"),
-			quad.IRI("isBlocking"),
-			quad.IRI("IsRelatedWith"),
-			quad.IRI("IsPartOf"),
-			quad.IRI("HasPart"),
-		))
-	}
-
-	tasks := dvmodel.Tasks{}
-	p = p.Limit(300) // nolint:gomnd
-	err := schema.LoadPathTo(ctx, h, &tasks, p)
+	values, err := p.Iterate(ctx).AllValues(h)
 	if err != nil {
-		return nil, fmt.Errorf("load tasks: %w", err)
+		return nil, fmt.Errorf("load task IDs: %w", err)
 	}
+	ids := uniqueIRIs(values)
 
-	if filters.WithoutIsolated {
-		tasks = dvmodel.FilterIsolatedTasks(tasks, logger)
+	if filters.Filter != nil {
+		ids, err = filterTaskIDs(ctx, h, schema, ids, filters.Filter, aliases)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	{ // remove duplicates
-		// FIXME: remove duplicates from the query itself
-		taskMap := map[quad.IRI]dvmodel.Task{}
-		for _, task := range tasks {
-			taskMap[task.ID] = task
+	if !filters.WithoutExternalDeps {
+		ids, err = expandDeps(ctx, h, ids, filters.DepsDirection, filters.depsDepth(), aliases)
+		if err != nil {
+			return nil, err
 		}
-		tasks = make(dvmodel.Tasks, len(taskMap))
-		i := 0
-		for _, task := range taskMap {
-			tasks[i] = task
-			i++
+	}
+
+	if filters.WithoutIsolated {
+		targeted := map[quad.IRI]bool{}
+		err := loadTaskChunks(ctx, h, schema, ids, func(tasks dvmodel.Tasks) {
+			for id := range dvmodel.TargetedTasks(tasks) {
+				targeted[id] = true
+			}
+		})
+		if err != nil {
+			return nil, err
 		}
+		kept := []quad.IRI{}
+		for _, id := range ids {
+			if targeted[id] {
+				kept = append(kept, id)
+			}
+		}
+		ids = kept
 	}
+	return ids, nil
+}
 
-	sort.Slice(tasks[:], func(i, j int) bool {
-		return tasks[i].ID < tasks[j].ID
+// filterTaskIDs returns the IDs of the tasks matching filter, the tasks are loaded in chunks.
+func filterTaskIDs(ctx context.Context, h *cayley.Handle, schema *schema.Config, ids []quad.IRI, filter *TaskFilter, aliases Aliases) ([]quad.IRI, error) {
+	kept := []quad.IRI{}
+	err := loadTaskChunks(ctx, h, schema, ids, func(tasks dvmodel.Tasks) {
+		for _, task := range tasks {
+			if filter.Match(aliases.canonicalTask(task)) {
+				kept = append(kept, task.ID)
+			}
+		}
 	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i] < kept[j] })
+	return kept, nil
+}
 
-	// fmt.Println(godev.PrettyJSON(tasks))
+// loadTaskChunks loads the stored tasks identified by ids, taskLoadChunk at a time, and passes each chunk to fn.
+func loadTaskChunks(ctx context.Context, h *cayley.Handle, schema *schema.Config, ids []quad.IRI, fn func(tasks dvmodel.Tasks)) error {
+	for start := 0; start < len(ids); start += taskLoadChunk {
+		end := start + taskLoadChunk
+		if end > len(ids) {
+			end = len(ids)
+		}
+		values := make([]quad.Value, 0, end-start)
+		for _, id := range ids[start:end] {
+			values = append(values, id)
+		}
+		tasks := dvmodel.Tasks{}
+		if err := schema.LoadPathTo(ctx, h, &tasks, path.StartPath(h, values...)); err != nil {
+			return fmt.Errorf("load tasks: %w", err)
+		}
+		fn(tasks)
+	}
+	return nil
+}
 
-	return tasks, nil
+// uniqueIRIs returns the sorted and deduplicated IRIs of values.
+func uniqueIRIs(values []quad.Value) []quad.IRI {
+	seen := map[quad.IRI]bool{}
+	iris := []quad.IRI{}
+	for _, value := range values {
+		if iri, ok := value.(quad.IRI); ok && !seen[iri] {
+			seen[iri] = true
+			iris = append(iris, iri)
+		}
+	}
+	sort.Slice(iris, func(i, j int) bool { return iris[i] < iris[j] })
+	return iris
 }
@@ -1,13 +1,17 @@
 package dvstore
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"strings"
 	"testing"
 
+	"github.com/cayleygraph/quad"
 	_ "github.com/cayleygraph/quad/json"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
 	"moul.io/depviz/v3/internal/dvparser"
 	"moul.io/depviz/v3/internal/testutil"
 	"moul.io/godev"
@@ -31,6 +35,10 @@ func TestLoadTasks(t *testing.T) {
 		{"all-depviz-test", "moul-depviz-test", LoadTasksFilters{Targets: parseTargets(t, "moul/depviz-test")}, nil},
 		{"all-depviz-test", "moulbot-depviz-test", LoadTasksFilters{Targets: parseTargets(t, "moul-bot/depviz-test")}, nil},
 		{"all-depviz-test", "moul-and-moulbot-depviz-test", LoadTasksFilters{Targets: parseTargets(t, "moul/depviz-test, moul-bot/depviz-test")}, nil},
+		{"all-depviz-test", "moul-depviz-test-issue", LoadTasksFilters{Targets: parseTargets(t, "moul/depviz-test#7")}, nil},
+		{"all-depviz-test", "moul-depviz-test-milestone", LoadTasksFilters{Targets: parseTargets(t, "https://github.com/moul/depviz-test/milestone/1"), WithoutExternalDeps: true}, nil},
+		{"all-depviz-test", "moul-depviz-test-label", LoadTasksFilters{Targets: parseTargets(t, "moul/depviz-test/labels/bug"), WithClosed: true}, nil},
+		{"all-depviz-test", "moulbot-user", LoadTasksFilters{Targets: parseTargets(t, "@moul-bot"), WithoutExternalDeps: true}, nil},
 	}
 	alreadySeen := map[string]bool{}
 	for _, testptr := range tests {
@@ -89,7 +97,87 @@ func TestLoadTasks(t *testing.T) {
 	}
 }
 
-func parseTargets(t *testing.T, input string) []multipmuri.Entity {
+func TestLoadTasksPage(t *testing.T) {
+	logger := testutil.Logger(t)
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	filters := LoadTasksFilters{TheWorld: true, WithClosed: true}
+	all, err := LoadTasks(store, schemaConfig, filters, logger)
+	require.NoError(t, err)
+	require.Greater(t, len(all), 5)
+
+	// paginate with the cursor until the end
+	filters.Limit = 5
+	paginated := dvmodel.Tasks{}
+	pages := 0
+	for {
+		page, err := LoadTasksPage(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		pages++
+		paginated = append(paginated, page.Tasks...)
+		if !page.Truncated {
+			assert.Empty(t, page.NextCursor)
+			break
+		}
+		assert.Len(t, page.Tasks, filters.Limit)
+		assert.Equal(t, page.Tasks[len(page.Tasks)-1].ID, page.NextCursor)
+		filters.After = page.NextCursor
+	}
+	assert.Equal(t, (len(all)+filters.Limit-1)/filters.Limit, pages)
+	assert.Equal(t, godev.JSON(all), godev.JSON(paginated))
+
+	// a limit matching the number of tasks is not a truncation
+	page, err := LoadTasksPage(store, schemaConfig, LoadTasksFilters{TheWorld: true, WithClosed: true, Limit: len(all)}, logger)
+	require.NoError(t, err)
+	assert.False(t, page.Truncated)
+	assert.Len(t, page.Tasks, len(all))
+
+	// streaming
+	it, err := IterateTasks(store, schemaConfig, LoadTasksFilters{TheWorld: true, WithClosed: true}, logger)
+	require.NoError(t, err)
+	streamed := 0
+	for it.Next() {
+		assert.Equal(t, all[streamed].ID, it.Task().ID)
+		streamed++
+	}
+	require.NoError(t, it.Err())
+	assert.False(t, it.Truncated())
+	assert.Equal(t, len(all), streamed)
+}
+
+func TestLoadCycles(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingStore(t)
+	defer close()
+
+	var (
+		first  = quad.IRI("https://github.com/acme/repo/issues/1")
+		second = quad.IRI("https://github.com/acme/repo/issues/2")
+	)
+	batch := dvmodel.Batch{Tasks: []*dvmodel.Task{
+		{ID: first, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, IsDependingOn: []quad.IRI{second}},
+		{ID: second, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, IsBlocking: []quad.IRI{first}, IsDependingOn: []quad.IRI{first}},
+	}}
+	require.NoError(t, SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{batch}, SnapshotNone))
+
+	// the cycle goes through both pages
+	filters := LoadTasksFilters{TheWorld: true, Limit: 1}
+	page, err := LoadTasksPage(store, schemaConfig, filters, logger)
+	require.NoError(t, err)
+	require.True(t, page.Truncated)
+	assert.Empty(t, page.Tasks.Cycles())
+	for _, after := range []quad.IRI{"", page.NextCursor} {
+		filters.After = after
+		cycles, err := LoadCycles(store, schemaConfig, filters, logger)
+		require.NoError(t, err)
+		require.Len(t, cycles, 1, string(after))
+		assert.Equal(t, []quad.IRI{first, second}, cycles[0].Path)
+	}
+}
+
+func parseTargets(t testing.TB, input string) []multipmuri.Entity {
 	t.Helper()
 	targets, err := dvparser.ParseTargets(strings.Split(input, ", "))
 	if !assert.NoError(t, err) {
@@ -0,0 +1,171 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// saveChunkSize is the maximum number of entities written per transaction by SaveBatches.
+const saveChunkSize = 500
+
+// SaveBatches saves batches in transactions of at most saveChunkSize entities, see saveChunk.
+//
+// The owners, tasks and topics replace their stored version, and the derived data (revisions,
+// search terms, inverse relationships) is updated in the same transaction.
+func SaveBatches(ctx context.Context, h *cayley.Handle, schema *schema.Config, batches []dvmodel.Batch, snapshots SnapshotPolicy) error {
+	for _, batch := range batches {
+		for _, chunk := range chunkBatch(batch, saveChunkSize) {
+			if err := saveChunk(ctx, h, schema, chunk, snapshots); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// chunkBatch splits batch into batches of at most size entities, keeping the owners, tasks and topics order.
+func chunkBatch(batch dvmodel.Batch, size int) []dvmodel.Batch {
+	chunks := []dvmodel.Batch{}
+	var (
+		current dvmodel.Batch
+		count   int
+	)
+	next := func() {
+		count++
+		if count == size {
+			chunks = append(chunks, current)
+			current, count = dvmodel.Batch{}, 0
+		}
+	}
+	for _, owner := range batch.Owners {
+		current.Owners = append(current.Owners, owner)
+		next()
+	}
+	for _, task := range batch.Tasks {
+		current.Tasks = append(current.Tasks, task)
+		next()
+	}
+	for _, topic := range batch.Topics {
+		current.Topics = append(current.Topics, topic)
+		next()
+	}
+	if count > 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// saveChunk saves batch in a single transaction, see applyTransaction: a chunk failing to save is rolled back,
+// the previous chunks stay saved.
+//
+// The stored version of the entities, their relationships, revisions and search terms are loaded with one query
+// per kind of data, and the stored entities are removed before writing the new ones.
+func saveChunk(ctx context.Context, h *cayley.Handle, schema *schema.Config, batch dvmodel.Batch, snapshots SnapshotPolicy) error {
+	tx := cayley.NewTransaction()
+	dw := graph.NewTxWriter(tx, graph.Delete)
+	iw := graph.NewTxWriter(tx, graph.Add)
+
+	// owners
+	{
+		ids := make([]quad.Value, len(batch.Owners))
+		for i, owner := range batch.Owners {
+			ids[i] = owner.ID
+		}
+		existing := []dvmodel.Owner{}
+		if len(ids) > 0 {
+			if err := schema.LoadPathTo(ctx, h, &existing, path.StartPath(h, ids...)); err != nil {
+				return fmt.Errorf("load owners: %w", err)
+			}
+		}
+		for _, working := range existing {
+			_, _ = schema.WriteAsQuads(dw, working)
+		}
+		for _, owner := range batch.Owners {
+			if _, err := schema.WriteAsQuads(iw, *owner); err != nil {
+				return fmt.Errorf("write as quads: %w", err)
+			}
+		}
+	}
+
+	// tasks
+	{
+		ids := make([]quad.Value, len(batch.Tasks))
+		for i, task := range batch.Tasks {
+			ids[i] = task.ID
+		}
+		existing := dvmodel.Tasks{}
+		if len(ids) > 0 {
+			if err := schema.LoadPathTo(ctx, h, &existing, path.StartPath(h, ids...)); err != nil {
+				return fmt.Errorf("load tasks: %w", err)
+			}
+		}
+		// the inverse relationships are maintained by MaterializeInverses
+		workings := make([]*dvmodel.Task, len(existing))
+		for i := range existing {
+			workings[i] = &existing[i]
+		}
+		if err := ExplicitRelations(ctx, h, workings); err != nil {
+			return fmt.Errorf("load relationships: %w", err)
+		}
+		for _, working := range existing {
+			_, _ = schema.WriteAsQuads(dw, working)
+		}
+		for _, task := range batch.Tasks {
+			if _, err := schema.WriteAsQuads(iw, *task); err != nil {
+				return fmt.Errorf("write as quads: %w", err)
+			}
+		}
+	}
+
+	// topics
+	{
+		ids := make([]quad.Value, len(batch.Topics))
+		for i, topic := range batch.Topics {
+			ids[i] = topic.ID
+		}
+		existing := []dvmodel.Topic{}
+		if len(ids) > 0 {
+			if err := schema.LoadPathTo(ctx, h, &existing, path.StartPath(h, ids...)); err != nil {
+				return fmt.Errorf("load topics: %w", err)
+			}
+		}
+		for _, working := range existing {
+			_, _ = schema.WriteAsQuads(dw, working)
+		}
+		for _, topic := range batch.Topics {
+			if _, err := schema.WriteAsQuads(iw, *topic); err != nil {
+				return fmt.Errorf("write as quads: %w", err)
+			}
+		}
+	}
+
+	if err := Snapshot(ctx, h, tx, batch.Tasks, snapshots, time.Now()); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+
+	if err := IndexTasks(ctx, h, tx, batch.Tasks); err != nil {
+		return fmt.Errorf("index tasks: %w", err)
+	}
+
+	// relationships with tasks of other chunks are completed when the other side is saved
+	if err := MaterializeInverses(ctx, h, tx, batch.Tasks); err != nil {
+		return fmt.Errorf("materialize inverse relationships: %w", err)
+	}
+
+	if err := StampSchemaVersion(ctx, h, tx); err != nil {
+		return fmt.Errorf("stamp schema version: %w", err)
+	}
+
+	if err := applyTransaction(ctx, h, tx); err != nil {
+		return fmt.Errorf("apply tx: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+func TestSaveBatchesChunks(t *testing.T) {
+	ctx := context.Background()
+	batch := syntheticBatch(40)
+
+	chunks := chunkBatch(batch, 7)
+	entities := 0
+	for _, chunk := range chunks {
+		size := len(chunk.Owners) + len(chunk.Tasks) + len(chunk.Topics)
+		assert.LessOrEqual(t, size, 7)
+		entities += size
+	}
+	assert.Equal(t, len(batch.Owners)+len(batch.Tasks)+len(batch.Topics), entities)
+	assert.Len(t, chunks, (entities+6)/7)
+
+	// saving chunk by chunk gives the same store as a single transaction
+	whole, closeWhole := TestingStore(t)
+	defer closeWhole()
+	require.NoError(t, saveChunk(ctx, whole, schemaConfig, batch, SnapshotNone))
+	chunked, closeChunked := TestingStore(t)
+	defer closeChunked()
+	for i := 0; i < 2; i++ { // the second pass updates the stored entities
+		for _, chunk := range chunks {
+			require.NoError(t, saveChunk(ctx, chunked, schemaConfig, chunk, SnapshotNone))
+		}
+	}
+	assert.Equal(t, storeQuads(t, whole), storeQuads(t, chunked))
+	problems, err := Diagnose(ctx, chunked)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestApplyTransactionRollback(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingStore(t)
+	defer close()
+
+	var (
+		dependsOn = quad.IRI("isDependingOn")
+		target    = quad.IRI("https://github.com/bench/repo/issues/1")
+	)
+	initial := cayley.NewTransaction()
+	for _, subject := range []quad.IRI{"https://github.com/bench/repo/issues/2", "https://github.com/bench/repo/issues/3", "https://github.com/bench/repo/issues/4"} {
+		initial.AddQuad(quad.Make(subject, dependsOn, target, nil))
+	}
+	require.NoError(t, applyTransaction(ctx, store, initial))
+	before := storeQuads(t, store)
+
+	// the target loses three references and gains two, so the additions are applied first,
+	// then rolled back when the removal of a missing quad fails
+	tx := cayley.NewTransaction()
+	tx.RemoveQuad(initial.Deltas[0].Quad)
+	tx.RemoveQuad(initial.Deltas[1].Quad)
+	tx.RemoveQuad(quad.Make(quad.IRI("https://github.com/bench/repo/issues/5"), dependsOn, target, nil))
+	tx.AddQuad(quad.Make(quad.IRI("https://github.com/bench/repo/issues/6"), dependsOn, target, nil))
+	tx.AddQuad(initial.Deltas[2].Quad) // already stored, kept by the rollback
+	require.Error(t, applyTransaction(ctx, store, tx))
+	assert.Equal(t, before, storeQuads(t, store))
+}
+
+// storeQuads returns the sorted quads of h.
+func storeQuads(t testing.TB, h *cayley.Handle) []string {
+	ctx := context.Background()
+	quads := []string{}
+	it := h.QuadsAllIterator()
+	defer it.Close()
+	for it.Next(ctx) {
+		quads = append(quads, h.Quad(it.Result()).NQuad())
+	}
+	require.NoError(t, it.Err())
+	sort.Strings(quads)
+	return quads
+}
+
+// syntheticBatch returns a batch of n issues spread over a repo, with labels and chains of dependencies.
+func syntheticBatch(n int) dvmodel.Batch {
+	var (
+		now   = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		user  = quad.IRI("https://github.com/bench")
+		repo  = quad.IRI("https://github.com/bench/repo")
+		batch = dvmodel.Batch{
+			Owners: []*dvmodel.Owner{
+				{ID: user, LocalID: "bench", Kind: dvmodel.Owner_User, Driver: dvmodel.Driver_GitHub},
+				{ID: repo, LocalID: "bench/repo", Kind: dvmodel.Owner_Repo, Driver: dvmodel.Driver_GitHub, HasOwner: user},
+			},
+		}
+	)
+	for i := 0; i < 10; i++ {
+		batch.Topics = append(batch.Topics, &dvmodel.Topic{
+			ID:       quad.IRI(fmt.Sprintf("%s/labels/label-%d", string(repo), i)),
+			LocalID:  fmt.Sprintf("label-%d", i),
+			Kind:     dvmodel.Topic_Label,
+			Driver:   dvmodel.Driver_GitHub,
+			HasOwner: repo,
+		})
+	}
+	for i := 1; i <= n; i++ {
+		updated := now.Add(time.Duration(i) * time.Minute)
+		task := &dvmodel.Task{
+			ID:        quad.IRI(fmt.Sprintf("%s/issues/%d", string(repo), i)),
+			LocalID:   fmt.Sprintf("bench/repo#%d", i),
+			Kind:      dvmodel.Task_Issue,
+			State:     dvmodel.Task_Open,
+			Title:     fmt.Sprintf("synthetic issue number %d", i),
+			Driver:    dvmodel.Driver_GitHub,
+			CreatedAt: &now,
+			UpdatedAt: &updated,
+			HasAuthor: user,
+			HasOwner:  repo,
+			HasLabel:  []quad.IRI{batch.Topics[i%len(batch.Topics)].ID},
+		}
+		if i%5 != 1 {
+			task.IsDependingOn = []quad.IRI{quad.IRI(fmt.Sprintf("%s/issues/%d", string(repo), i-1))}
+		}
+		batch.Tasks = append(batch.Tasks, task)
+	}
+	return batch
+}
+
+// saveBatchesPerEntity is the implementation of SaveBatches before chunking: a single transaction,
+// and store lookups per entity, for the entities themselves and for their derived data.
+func saveBatchesPerEntity(h *cayley.Handle, batches []dvmodel.Batch, snapshots SnapshotPolicy) error {
+	ctx := context.Background()
+
+	tx := cayley.NewTransaction()
+	dw := graph.NewTxWriter(tx, graph.Delete)
+	iw := graph.NewTxWriter(tx, graph.Add)
+
+	for _, batch := range batches {
+		for _, owner := range batch.Owners {
+			var working dvmodel.Owner
+			if err := schemaConfig.LoadTo(ctx, h, &working, owner.ID); err == nil {
+				_, _ = schemaConfig.WriteAsQuads(dw, working)
+			}
+			if _, err := schemaConfig.WriteAsQuads(iw, *owner); err != nil {
+				return fmt.Errorf("write as quads: %w", err)
+			}
+		}
+		for _, task := range batch.Tasks {
+			var working dvmodel.Task
+			if err := schemaConfig.LoadTo(ctx, h, &working, task.ID); err == nil {
+				if err := ExplicitRelations(ctx, h, []*dvmodel.Task{&working}); err != nil {
+					return fmt.Errorf("load relationships: %w", err)
+				}
+				_, _ = schemaConfig.WriteAsQuads(dw, working)
+			}
+			if _, err := schemaConfig.WriteAsQuads(iw, *task); err != nil {
+				return fmt.Errorf("write as quads: %w", err)
+			}
+		}
+		for _, topic := range batch.Topics {
+			var working dvmodel.Topic
+			if err := schemaConfig.LoadTo(ctx, h, &working, topic.ID); err == nil {
+				_, _ = schemaConfig.WriteAsQuads(dw, working)
+			}
+			if _, err := schemaConfig.WriteAsQuads(iw, *topic); err != nil {
+				return fmt.Errorf("write as quads: %w", err)
+			}
+		}
+	}
+
+	tasks := []*dvmodel.Task{}
+	for _, batch := range batches {
+		tasks = append(tasks, batch.Tasks...)
+	}
+	for _, task := range tasks {
+		if err := Snapshot(ctx, h, tx, []*dvmodel.Task{task}, snapshots, time.Now()); err != nil {
+			return fmt.Errorf("snapshot: %w", err)
+		}
+		if err := IndexTasks(ctx, h, tx, []*dvmodel.Task{task}); err != nil {
+			return fmt.Errorf("index tasks: %w", err)
+		}
+	}
+	for _, task := range tasks {
+		if err := MaterializeInverses(ctx, h, tx, []*dvmodel.Task{task}); err != nil {
+			return fmt.Errorf("materialize inverse relationships: %w", err)
+		}
+	}
+
+	if err := StampSchemaVersion(ctx, h, tx); err != nil {
+		return fmt.Errorf("stamp schema version: %w", err)
+	}
+	return applyTransaction(ctx, h, tx)
+}
+
+// BenchmarkSaveBatches saves a synthetic batch in an empty store (initial sync) and in a store already containing it (resync),
+// with revisions recorded for every sync.
+//
+//	go test ./internal/dvstore -run ^$ -bench SaveBatches -benchmem
+func BenchmarkSaveBatches(b *testing.B) {
+	batch := syntheticBatch(2000)
+	save := map[string]func(h *cayley.Handle) error{
+		"per-entity": func(h *cayley.Handle) error { return saveBatchesPerEntity(h, []dvmodel.Batch{batch}, SnapshotPerSync) },
+		"chunked": func(h *cayley.Handle) error {
+			return SaveBatches(context.Background(), h, schemaConfig, []dvmodel.Batch{batch}, SnapshotPerSync)
+		},
+	}
+	for _, name := range []string{"per-entity", "chunked"} {
+		for _, resync := range []bool{false, true} {
+			phase := "initial"
+			if resync {
+				phase = "resync"
+			}
+			b.Run(name+"/"+phase, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					store, close := TestingStore(b)
+					if resync {
+						require.NoError(b, save[name](store))
+					}
+					b.StartTimer()
+					require.NoError(b, save[name](store))
+					b.StopTimer()
+					close()
+				}
+			})
+		}
+	}
+}
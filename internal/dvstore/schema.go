@@ -2,9 +2,25 @@ package dvstore
 
 import (
 	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
 	"moul.io/depviz/v3/internal/dvmodel"
 )
 
+// RelationshipPredicates lists the predicates linking an entity to another one.
+var RelationshipPredicates = []quad.IRI{
+	"hasAuthor",
+	"hasOwner",
+	"hasMilestone",
+	"hasAssignee",
+	"hasReviewer",
+	"hasLabel",
+	"isDependingOn",
+	"isBlocking",
+	"isRelatedWith",
+	"isPartOf",
+	"hasPart",
+}
+
 func Schema() *schema.Config {
 	config := schema.NewConfig()
 	// temporarily forced to register it globally :(
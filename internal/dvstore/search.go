@@ -0,0 +1,357 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// SearchTermPredicate links a task to the terms of its full-text index, see IndexTasks.
+const SearchTermPredicate = quad.IRI("dv:searchTerm")
+
+// searchIndexPredicate marks the stores whose tasks were all indexed, see ensureSearchIndex.
+var searchIndexPredicate = quad.IRI("dv:searchIndex")
+
+const (
+	// DefaultSearchLimit is the number of results returned by Search when SearchOpts.Limit is unset.
+	DefaultSearchLimit = 20
+	// MaxSearchLimit is the maximum number of results returned by Search.
+	MaxSearchLimit = 100
+)
+
+// searchFields are the indexed fields of a task, with their weight in the ranking.
+var searchFields = []struct {
+	name   string
+	weight float64
+}{
+	{"title", 3},
+	{"labels", 2},
+	{"authors", 2},
+	{"description", 1},
+}
+
+type SearchOpts struct {
+	// Limit defaults to DefaultSearchLimit and is capped to MaxSearchLimit.
+	Limit      int
+	WithClosed bool
+	// HighlightPre and HighlightPost surround the matching terms in the highlights, default to <em> and </em>.
+	HighlightPre  string
+	HighlightPost string
+}
+
+type SearchResult struct {
+	Task       dvmodel.Task      `json:"task"`
+	Score      float64           `json:"score"`
+	Highlights []SearchHighlight `json:"highlights,omitempty"`
+}
+
+// SearchHighlight is an excerpt of a matching field.
+type SearchHighlight struct {
+	Field    string `json:"field"`
+	Fragment string `json:"fragment"`
+}
+
+// IndexTasks adds the deltas needed to keep the full-text index of tasks up to date to tx.
+//
+// Removing terms can lower the reference count of a task, tx has to be applied with applyTransaction.
+func IndexTasks(ctx context.Context, h *cayley.Handle, tx *graph.Transaction, tasks []*dvmodel.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	ids := make([]quad.Value, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	quads, err := quadsWithAny(ctx, h, quad.Subject, ids, SearchTermPredicate)
+	if err != nil {
+		return fmt.Errorf("load search terms: %w", err)
+	}
+	olds := map[quad.IRI][]quad.Value{}
+	for _, q := range quads {
+		subject, _ := q.Subject.(quad.IRI)
+		olds[subject] = append(olds[subject], q.Object)
+	}
+	for _, task := range tasks {
+		indexTask(tx, *task, olds[task.ID])
+	}
+	return nil
+}
+
+// indexTask adds the deltas between the terms of task and olds, its stored terms, to tx.
+func indexTask(tx *graph.Transaction, task dvmodel.Task, olds []quad.Value) {
+	terms := map[string]bool{}
+	for _, text := range searchDocument(task) {
+		for _, term := range searchTerms(text) {
+			terms[term] = true
+		}
+	}
+
+	for _, old := range olds {
+		term, _ := old.(quad.String)
+		if terms[string(term)] {
+			delete(terms, string(term)) // already indexed
+			continue
+		}
+		tx.RemoveQuad(quad.Make(task.ID, SearchTermPredicate, old, nil))
+	}
+	for term := range terms {
+		tx.AddQuad(quad.Make(task.ID, SearchTermPredicate, quad.String(term), nil))
+	}
+}
+
+// ensureSearchIndex indexes every stored task, unless it was already done. The index is derived from the tasks,
+// so it is built lazily instead of requiring a migration of the stores saved before it existed.
+//
+// The tasks are indexed by chunks, in their own transaction, and the store is marked as indexed at the end:
+// an interrupted build is started over by the next search.
+func ensureSearchIndex(ctx context.Context, h *cayley.Handle, schema *schema.Config) error {
+	marks, err := path.StartPath(h, schemaVersionSubject).Out(searchIndexPredicate).Iterate(ctx).AllValues(h)
+	if err != nil {
+		return fmt.Errorf("load search index mark: %w", err)
+	}
+	if len(marks) > 0 {
+		return nil
+	}
+
+	values, err := path.StartPath(h).Has(quad.IRI("rdf:type"), quad.IRI("dv:Task")).Iterate(ctx).AllValues(h)
+	if err != nil {
+		return fmt.Errorf("load tasks: %w", err)
+	}
+	var indexErr error
+	err = loadTaskChunks(ctx, h, schema, uniqueIRIs(values), func(tasks dvmodel.Tasks) {
+		if indexErr != nil {
+			return
+		}
+		pointers := make([]*dvmodel.Task, len(tasks))
+		for i := range tasks {
+			pointers[i] = &tasks[i]
+		}
+		tx := cayley.NewTransaction()
+		if indexErr = IndexTasks(ctx, h, tx, pointers); indexErr == nil && len(tx.Deltas) > 0 {
+			indexErr = applyTransaction(ctx, h, tx)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if indexErr != nil {
+		return indexErr
+	}
+
+	tx := cayley.NewTransaction()
+	tx.AddQuad(quad.Make(schemaVersionSubject, searchIndexPredicate, quad.Bool(true), nil))
+	return h.ApplyTransaction(tx)
+}
+
+// Search returns the tasks matching at least one term of query, best matches first.
+//
+// Tasks are ranked by the weighted frequency of the terms in their fields,
+// multiplied by the ratio of query terms they contain.
+//
+// The index is built on the first search in a store saved before it existed, see ensureSearchIndex.
+func Search(ctx context.Context, h *cayley.Handle, schema *schema.Config, query string, opts SearchOpts) ([]SearchResult, error) {
+	switch {
+	case opts.Limit <= 0:
+		opts.Limit = DefaultSearchLimit
+	case opts.Limit > MaxSearchLimit:
+		opts.Limit = MaxSearchLimit
+	}
+	if opts.HighlightPre == "" && opts.HighlightPost == "" {
+		opts.HighlightPre, opts.HighlightPost = "<em>", "</em>"
+	}
+	queryTerms := map[string]bool{}
+	for _, term := range searchTerms(query) {
+		queryTerms[term] = true
+	}
+	if len(queryTerms) == 0 {
+		return nil, fmt.Errorf("empty search query")
+	}
+
+	if err := ensureSearchIndex(ctx, h, schema); err != nil {
+		return nil, fmt.Errorf("build search index: %w", err)
+	}
+
+	candidates := map[quad.IRI]bool{}
+	for term := range queryTerms {
+		subjects, err := path.StartPath(h, quad.String(term)).In(SearchTermPredicate).Iterate(ctx).AllValues(h)
+		if err != nil {
+			return nil, fmt.Errorf("search %q: %w", term, err)
+		}
+		for _, subject := range subjects {
+			if id, ok := subject.(quad.IRI); ok {
+				candidates[id] = true
+			}
+		}
+	}
+
+	results := []SearchResult{}
+	for id := range candidates {
+		var task dvmodel.Task
+		if err := schema.LoadTo(ctx, h, &task, id); err != nil {
+			return nil, fmt.Errorf("load task %q: %w", id, err)
+		}
+		if !opts.WithClosed && task.State != dvmodel.Task_Open {
+			continue
+		}
+
+		result := SearchResult{Task: task}
+		document := searchDocument(task)
+		matched := map[string]bool{}
+		for _, field := range searchFields {
+			frequency := 0
+			for _, term := range searchTerms(document[field.name]) {
+				if queryTerms[term] {
+					matched[term] = true
+					frequency++
+				}
+			}
+			if frequency == 0 {
+				continue
+			}
+			result.Score += field.weight * float64(frequency)
+			window := 0
+			if field.name == "description" {
+				window = 60
+			}
+			result.Highlights = append(result.Highlights, SearchHighlight{
+				Field:    field.name,
+				Fragment: highlight(document[field.name], queryTerms, opts.HighlightPre, opts.HighlightPost, window),
+			})
+		}
+		if len(matched) == 0 { // stale index
+			continue
+		}
+		result.Score *= float64(len(matched)) / float64(len(queryTerms))
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Task.ID < results[j].Task.ID
+	})
+	if len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results, nil
+}
+
+// searchDocument returns the indexed text of a task, by field.
+//
+// Labels and authors are indexed by the last part of their IRI, i.e. their name for GitHub.
+func searchDocument(task dvmodel.Task) map[string]string {
+	names := func(iris ...quad.IRI) string {
+		parts := []string{}
+		for _, iri := range iris {
+			if iri == "" {
+				continue
+			}
+			name := strings.TrimSuffix(string(iri), "/")
+			name = name[strings.LastIndex(name, "/")+1:]
+			if unescaped, err := url.PathUnescape(name); err == nil {
+				name = unescaped
+			}
+			parts = append(parts, name)
+		}
+		return strings.Join(parts, " ")
+	}
+	return map[string]string{
+		"title":       task.Title,
+		"labels":      names(task.HasLabel...),
+		"authors":     names(task.HasAuthor),
+		"description": strings.Join(strings.Fields(task.Description), " "),
+	}
+}
+
+// searchTerms splits text into lowercase words of at least two letters or digits.
+func searchTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), isNotWordRune)
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if len([]rune(word)) >= 2 {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// highlight surrounds the terms of text with pre and post.
+//
+// If window is positive, the fragment is cut around the first match, without splitting words.
+func highlight(text string, terms map[string]bool, pre, post string, window int) string {
+	runes := []rune(text)
+	type word struct{ start, end int }
+	words := []word{}
+	start := -1
+	for i, r := range runes {
+		switch {
+		case !isNotWordRune(r) && start < 0:
+			start = i
+		case isNotWordRune(r) && start >= 0:
+			words = append(words, word{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, word{start, len(runes)})
+	}
+	isMatch := func(w word) bool { return terms[strings.ToLower(string(runes[w.start:w.end]))] }
+
+	lo, hi := 0, len(runes)
+	if window > 0 {
+		for _, w := range words {
+			if isMatch(w) {
+				if w.start > window {
+					lo = w.start - window
+				}
+				if w.start+2*window < hi {
+					hi = w.start + 2*window
+				}
+				break
+			}
+		}
+		for _, w := range words { // do not split words
+			if w.start < lo && lo < w.end {
+				lo = w.end
+			}
+			if w.start < hi && hi < w.end {
+				hi = w.start
+			}
+		}
+	}
+
+	var b strings.Builder
+	cursor := lo
+	for _, w := range words {
+		if w.start < lo || w.end > hi || !isMatch(w) {
+			continue
+		}
+		b.WriteString(string(runes[cursor:w.start]))
+		b.WriteString(pre + string(runes[w.start:w.end]) + post)
+		cursor = w.end
+	}
+	b.WriteString(string(runes[cursor:hi]))
+	fragment := strings.TrimSpace(b.String())
+	if lo > 0 {
+		fragment = "…" + fragment
+	}
+	if hi < len(runes) {
+		fragment += "…"
+	}
+	return fragment
+}
@@ -0,0 +1,87 @@
+package dvstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingGoldenStore(t, "all-depviz-test")
+	defer close()
+
+	// legacy store, indexed by the first search
+	_, _, err := Migrate(ctx, store, logger)
+	require.NoError(t, err)
+	assert.Equal(t, 0, countPredicate(t, store, SearchTermPredicate))
+
+	search := func(query string, opts SearchOpts) []string {
+		results, err := Search(ctx, store, schemaConfig, query, opts)
+		require.NoError(t, err, query)
+		ids := []string{}
+		for _, result := range results {
+			ids = append(ids, result.Task.LocalID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"moul/depviz-test#1"}, search("standard", SearchOpts{}))
+	indexed := countPredicate(t, store, SearchTermPredicate)
+	assert.Greater(t, indexed, 0)
+	assert.Equal(t, 1, countPredicate(t, store, searchIndexPredicate))
+	assert.Equal(t, []string{"moul/depviz-test#1"}, search("STANDARD!", SearchOpts{}), "case and punctuation are ignored")
+	assert.Equal(t, []string{"moul/depviz-test#2"}, search("documentation", SearchOpts{WithClosed: true}), "labels are indexed")
+	assert.Empty(t, search("unknownterm", SearchOpts{}))
+	assert.Len(t, search("issue", SearchOpts{Limit: 3}), 3)
+
+	// tasks matching every term come first
+	ids := search("isolated group another", SearchOpts{})
+	require.Greater(t, len(ids), 2)
+	assert.Equal(t, []string{"moul/depviz-test#9", "moul/depviz-test#8"}, ids[:2])
+
+	results, err := Search(ctx, store, schemaConfig, "standard", SearchOpts{HighlightPre: "[", HighlightPost: "]"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []SearchHighlight{{Field: "title", Fragment: "I'm a [standard] issue"}}, results[0].Highlights)
+
+	_, err = Search(ctx, store, schemaConfig, " ! ", SearchOpts{})
+	assert.Error(t, err)
+
+	// reindexing a task drops its stale terms
+	var task dvmodel.Task
+	require.NoError(t, schemaConfig.LoadTo(ctx, store, &task, quad.IRI("https://github.com/moul/depviz-test/issues/1")))
+	task.Title = "I'm a renamed issue"
+	tx := cayley.NewTransaction()
+	require.NoError(t, IndexTasks(ctx, store, tx, []*dvmodel.Task{&task}))
+	require.NoError(t, applyTransaction(ctx, store, tx))
+	assert.Empty(t, search("standard", SearchOpts{}))
+	tx = cayley.NewTransaction()
+	require.NoError(t, IndexTasks(ctx, store, tx, []*dvmodel.Task{&task}))
+	assert.Empty(t, tx.Deltas, "an up to date index is not rewritten")
+}
+
+func TestHighlight(t *testing.T) {
+	terms := map[string]bool{"foo": true, "bär": true}
+	tests := []struct {
+		text     string
+		window   int
+		expected string
+	}{
+		{"foo bar", 0, "<foo> bar"},
+		{"Foo, foobar and BÄR.", 0, "<Foo>, foobar and <BÄR>."},
+		{"nothing", 0, "nothing"},
+		{"aaa bbb ccc foo ddd eee fff ggg", 4, "…ccc <foo> ddd…"},
+		{"aaa bbb ccc foo ddd eee fff ggg", 100, "aaa bbb ccc <foo> ddd eee fff ggg"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, highlight(test.text, terms, "<", ">", test.window), test.text)
+	}
+}
@@ -0,0 +1,288 @@
+package dvstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/schema"
+	"github.com/cayleygraph/quad"
+	"go.uber.org/zap"
+	"moul.io/depviz/v3/internal/dvmodel"
+)
+
+// RevisionPredicate links a task to the JSON-encoded Revisions of its previous states.
+const RevisionPredicate = quad.IRI("dv:revision")
+
+// SnapshotPolicy configures how often task revisions are recorded when saving.
+type SnapshotPolicy string
+
+const (
+	// SnapshotNone disables the history.
+	SnapshotNone SnapshotPolicy = ""
+	// SnapshotPerSync records a revision every time a task changes.
+	SnapshotPerSync SnapshotPolicy = "sync"
+	// SnapshotDaily keeps at most one revision per task and per day, the last one.
+	SnapshotDaily SnapshotPolicy = "daily"
+)
+
+func ParseSnapshotPolicy(input string) (SnapshotPolicy, error) {
+	switch policy := SnapshotPolicy(input); policy {
+	case SnapshotPerSync, SnapshotDaily:
+		return policy, nil
+	case "off", "none", SnapshotNone:
+		return SnapshotNone, nil
+	default:
+		return SnapshotNone, fmt.Errorf("invalid snapshot policy: %q (sync, daily, off)", input)
+	}
+}
+
+// Revision is the state of a task starting from At, its last update time.
+type Revision struct {
+	At   time.Time    `json:"at"`
+	Task dvmodel.Task `json:"task"`
+}
+
+// Snapshot adds the revisions of tasks to tx, according to policy.
+//
+// A revision is only recorded when the task differs from its previous
+// revision, so snapshotting the same data twice is a no-op.
+func Snapshot(ctx context.Context, h *cayley.Handle, tx *graph.Transaction, tasks []*dvmodel.Task, policy SnapshotPolicy, now time.Time) error {
+	if policy == SnapshotNone {
+		return nil
+	}
+
+	ids := make([]quad.IRI, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	revisions, err := loadRevisionsOf(ctx, h, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		revision := Revision{At: now.UTC(), Task: *task}
+		if task.UpdatedAt != nil {
+			revision.At = task.UpdatedAt.UTC()
+		}
+		encoded, err := json.Marshal(revision)
+		if err != nil {
+			return fmt.Errorf("encode revision: %w", err)
+		}
+
+		previous := revisions[task.ID]
+		if len(previous) > 0 {
+			last := previous[len(previous)-1]
+			lastEncoded, _ := json.Marshal(last.revision)
+			switch {
+			case string(lastEncoded) == string(encoded):
+				continue
+			case last.revision.At.Equal(revision.At),
+				policy == SnapshotDaily && sameDay(last.revision.At, revision.At) && last.revision.At.Before(revision.At):
+				tx.RemoveQuad(quad.Make(task.ID, RevisionPredicate, last.value, nil))
+			}
+		}
+		tx.AddQuad(quad.Make(task.ID, RevisionPredicate, quad.String(encoded), nil))
+	}
+	return nil
+}
+
+// ErrNoSnapshot is returned when loading the tasks as of a time at which none of the targets' tasks was recorded.
+var ErrNoSnapshot = errors.New("no snapshot data")
+
+// loadTasksAsOf returns the tasks as they were at filters.AsOf, from their
+// recorded revisions, with the same filters as the LoadTasks query.
+//
+// Tasks created before that time but without any revision at that time, i.e. synced before
+// snapshots were enabled, are left out: a warning is logged, and ErrNoSnapshot is returned if
+// none of the matching tasks has a revision at that time.
+func loadTasksAsOf(ctx context.Context, h *cayley.Handle, schema *schema.Config, filters LoadTasksFilters, aliases Aliases, logger *zap.Logger) (dvmodel.Tasks, error) {
+	subjects, err := path.StartPath(h).Has(RevisionPredicate).Iterate(ctx).AllValues(h)
+	if err != nil {
+		return nil, fmt.Errorf("load revisions: %w", err)
+	}
+	revisionsByID, err := loadRevisionsOf(ctx, h, uniqueIRIs(subjects))
+	if err != nil {
+		return nil, err
+	}
+	all := map[quad.IRI]dvmodel.Task{}
+	for id, revisions := range revisionsByID {
+		for i := len(revisions) - 1; i >= 0; i-- {
+			if !revisions[i].revision.At.After(*filters.AsOf) {
+				all[id] = revisions[i].revision.Task
+				break
+			}
+		}
+	}
+
+	addInverses(all)
+
+	selected, err := selectTasks(ctx, h, all, filters, aliases)
+	if err != nil {
+		return nil, err
+	}
+	unrecorded, err := unrecordedTasks(ctx, h, schema, revisionsByID, filters, aliases)
+	if err != nil {
+		return nil, err
+	}
+	if len(unrecorded) > 0 {
+		if len(selected) == 0 {
+			return nil, fmt.Errorf("%w at %s for the targets, %d tasks existed but were not recorded", ErrNoSnapshot, filters.AsOf.Format(time.RFC3339), len(unrecorded))
+		}
+		logger.Warn("tasks without snapshot data at this time are left out", zap.Time("as-of", *filters.AsOf), zap.Int("tasks", len(unrecorded)))
+	}
+	if !filters.WithoutExternalDeps {
+		ids := make([]quad.IRI, 0, len(selected))
+		for id := range selected {
+			ids = append(ids, id)
+		}
+		for _, id := range expandDepsIn(all, ids, filters.DepsDirection, filters.depsDepth(), aliases) {
+			selected[id] = true
+		}
+	}
+
+	tasks := make(dvmodel.Tasks, 0, len(selected))
+	for id := range selected {
+		if task, found := all[id]; found {
+			tasks = append(tasks, task)
+		}
+	}
+	if filters.WithoutIsolated {
+		tasks = dvmodel.FilterIsolatedTasks(tasks, logger)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].ID < tasks[j].ID
+	})
+	return tasks, nil
+}
+
+// selectTasks returns the tasks of all matching the targets and filters, with the same semantics as the LoadTasks query.
+func selectTasks(ctx context.Context, h *cayley.Handle, all map[quad.IRI]dvmodel.Task, filters LoadTasksFilters, aliases Aliases) (map[quad.IRI]bool, error) {
+	matchers := make([]func(dvmodel.Task) bool, 0, len(filters.Targets))
+	for _, target := range filters.Targets {
+		matcher, err := targetMatcher(ctx, h, target, all)
+		if err != nil {
+			return nil, fmt.Errorf("resolve target %q: %w", target.String(), err)
+		}
+		matchers = append(matchers, matcher)
+	}
+	selected := map[quad.IRI]bool{}
+	for id, task := range all {
+		if !filters.TheWorld {
+			matches := false
+			for _, matcher := range matchers {
+				matches = matches || matcher(task)
+			}
+			if !matches {
+				continue
+			}
+		}
+		switch task.Kind {
+		case dvmodel.Task_Issue, dvmodel.Task_Milestone, dvmodel.Task_Epic, dvmodel.Task_Story, dvmodel.Task_Card:
+		case dvmodel.Task_MergeRequest:
+			if filters.WithoutPRs {
+				continue
+			}
+		default:
+			continue
+		}
+		if !filters.WithClosed && task.State != dvmodel.Task_Open {
+			continue
+		}
+		if !filters.Filter.Match(aliases.canonicalTask(task)) {
+			continue
+		}
+		selected[id] = true
+	}
+	return selected, nil
+}
+
+// unrecordedTasks returns the tasks matching the targets that were created before filters.AsOf
+// but have no revision at that time.
+func unrecordedTasks(ctx context.Context, h *cayley.Handle, schema *schema.Config, revisionsByID map[quad.IRI][]storedRevision, filters LoadTasksFilters, aliases Aliases) (map[quad.IRI]bool, error) {
+	candidates := map[quad.IRI]dvmodel.Task{}
+	for id, revisions := range revisionsByID {
+		if first := revisions[0].revision; first.At.After(*filters.AsOf) {
+			candidates[id] = first.Task
+		}
+	}
+	withoutRevision := []dvmodel.Task{}
+	p := path.StartPath(h).Has(quad.IRI("rdf:type"), quad.IRI("dv:Task")).Except(path.StartPath(h).Has(RevisionPredicate))
+	if err := schema.LoadPathTo(ctx, h, &withoutRevision, p); err != nil {
+		return nil, fmt.Errorf("load tasks without revision: %w", err)
+	}
+	for _, task := range withoutRevision {
+		candidates[task.ID] = task
+	}
+	for id, task := range candidates {
+		if task.CreatedAt == nil || task.CreatedAt.After(*filters.AsOf) {
+			delete(candidates, id)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// their state at that time is unknown, only the targets are matched
+	filters.WithClosed = true
+	filters.Filter = nil
+	return selectTasks(ctx, h, candidates, filters, aliases)
+}
+
+type storedRevision struct {
+	value    quad.Value
+	revision Revision
+}
+
+// loadRevisions returns the revisions of a task, sorted by time.
+func loadRevisions(ctx context.Context, h *cayley.Handle, id quad.IRI) ([]storedRevision, error) {
+	revisions, err := loadRevisionsOf(ctx, h, []quad.IRI{id})
+	if err != nil {
+		return nil, err
+	}
+	return revisions[id], nil
+}
+
+// loadRevisionsOf returns the revisions of tasks by task, sorted by time, with a single query.
+func loadRevisionsOf(ctx context.Context, h *cayley.Handle, ids []quad.IRI) (map[quad.IRI][]storedRevision, error) {
+	values := make([]quad.Value, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	quads, err := quadsWithAny(ctx, h, quad.Subject, values, RevisionPredicate)
+	if err != nil {
+		return nil, fmt.Errorf("load revisions: %w", err)
+	}
+	revisions := map[quad.IRI][]storedRevision{}
+	for _, q := range quads {
+		id, _ := q.Subject.(quad.IRI)
+		encoded, ok := q.Object.(quad.String)
+		if !ok {
+			continue
+		}
+		stored := storedRevision{value: q.Object}
+		if err := json.Unmarshal([]byte(encoded), &stored.revision); err != nil {
+			return nil, fmt.Errorf("decode revision of %q: %w", id, err)
+		}
+		revisions[id] = append(revisions[id], stored)
+	}
+	for _, stored := range revisions {
+		sort.Slice(stored, func(i, j int) bool {
+			return stored[i].revision.At.Before(stored[j].revision.At)
+		})
+	}
+	return revisions, nil
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.UTC().Date()
+	by, bm, bd := b.UTC().Date()
+	return ay == by && am == bm && ad == bd
+}
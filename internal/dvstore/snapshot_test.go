@@ -0,0 +1,143 @@
+package dvstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/quad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/depviz/v3/internal/testutil"
+)
+
+func TestSnapshotAndLoadTasksAsOf(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingStore(t)
+	defer close()
+
+	var (
+		repo = quad.IRI("https://github.com/moul/depviz-test")
+		id   = quad.IRI("https://github.com/moul/depviz-test/issues/1")
+		jan  = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+		feb  = time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)
+		now  = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	)
+	task := func(title string, state dvmodel.Task_State, updatedAt time.Time) *dvmodel.Task {
+		return &dvmodel.Task{ID: id, Title: title, Kind: dvmodel.Task_Issue, State: state, HasOwner: repo, UpdatedAt: &updatedAt}
+	}
+	snapshot := func(policy SnapshotPolicy, tasks ...*dvmodel.Task) int {
+		tx := cayley.NewTransaction()
+		require.NoError(t, Snapshot(ctx, store, tx, tasks, policy, now))
+		require.NoError(t, store.ApplyTransaction(tx))
+		return len(tx.Deltas)
+	}
+
+	assert.Equal(t, 0, snapshot(SnapshotNone, task("first", dvmodel.Task_Open, jan)))
+	assert.Equal(t, 1, snapshot(SnapshotPerSync, task("first", dvmodel.Task_Open, jan)))
+	assert.Equal(t, 0, snapshot(SnapshotPerSync, task("first", dvmodel.Task_Open, jan)), "unchanged tasks are not recorded twice")
+	assert.Equal(t, 1, snapshot(SnapshotPerSync, task("second", dvmodel.Task_Closed, feb)))
+	assert.Equal(t, 2, countPredicate(t, store, RevisionPredicate))
+
+	tests := []struct {
+		name     string
+		filters  LoadTasksFilters
+		expected []string
+	}{
+		{"before-first-sync", LoadTasksFilters{TheWorld: true, AsOf: timePtr(jan.Add(-time.Hour))}, []string{}},
+		{"first", LoadTasksFilters{TheWorld: true, AsOf: timePtr(jan)}, []string{"first"}},
+		{"first-by-target", LoadTasksFilters{Targets: parseTargets(t, "moul/depviz-test"), AsOf: timePtr(feb.Add(-time.Hour))}, []string{"first"}},
+		{"other-target", LoadTasksFilters{Targets: parseTargets(t, "moul-bot/depviz-test"), AsOf: timePtr(feb.Add(-time.Hour))}, []string{}},
+		{"second-closed", LoadTasksFilters{TheWorld: true, AsOf: timePtr(feb)}, []string{}},
+		{"second-with-closed", LoadTasksFilters{TheWorld: true, WithClosed: true, AsOf: timePtr(now)}, []string{"second"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tasks, err := LoadTasks(store, schemaConfig, test.filters, logger)
+			require.NoError(t, err)
+			titles := []string{}
+			for _, task := range tasks {
+				titles = append(titles, task.Title)
+			}
+			assert.Equal(t, test.expected, titles)
+		})
+	}
+}
+
+func TestLoadTasksAsOfWithoutSnapshot(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.Logger(t)
+	store, close := TestingStore(t)
+	defer close()
+
+	var (
+		repo      = quad.IRI("https://github.com/moul/depviz-test")
+		createdAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		asOf      = time.Now().Add(time.Hour)
+		targets   = parseTargets(t, "moul/depviz-test")
+	)
+	save := func(number string, policy SnapshotPolicy) {
+		task := &dvmodel.Task{ID: repo + "/issues/" + quad.IRI(number), Title: number, Kind: dvmodel.Task_Issue, State: dvmodel.Task_Open, HasOwner: repo, CreatedAt: &createdAt}
+		require.NoError(t, SaveBatches(ctx, store, schemaConfig, []dvmodel.Batch{{Tasks: []*dvmodel.Task{task}}}, policy))
+	}
+
+	// synced before snapshots were enabled
+	save("1", SnapshotNone)
+	_, err := LoadTasks(store, schemaConfig, LoadTasksFilters{Targets: targets, AsOf: timePtr(asOf)}, logger)
+	assert.True(t, errors.Is(err, ErrNoSnapshot), err)
+	tasks, err := LoadTasks(store, schemaConfig, LoadTasksFilters{Targets: parseTargets(t, "moul-bot/depviz-test"), AsOf: timePtr(asOf)}, logger)
+	require.NoError(t, err, "other targets are not affected")
+	assert.Empty(t, tasks)
+	tasks, err = LoadTasks(store, schemaConfig, LoadTasksFilters{Targets: targets, AsOf: timePtr(createdAt.Add(-time.Hour))}, logger)
+	require.NoError(t, err, "the task did not exist yet")
+	assert.Empty(t, tasks)
+
+	// the recorded tasks are loaded, the others are left out with a warning
+	save("2", SnapshotPerSync)
+	tasks, err = LoadTasks(store, schemaConfig, LoadTasksFilters{Targets: targets, AsOf: timePtr(asOf)}, logger)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "2", tasks[0].Title)
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+func TestSnapshotDaily(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingStore(t)
+	defer close()
+
+	id := quad.IRI("https://github.com/moul/depviz-test/issues/1")
+	for _, at := range []time.Time{
+		time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC), // replaces the previous one
+		time.Date(2026, 1, 2, 18, 0, 0, 0, time.UTC), // replaces the previous one
+	} {
+		updatedAt := at
+		tx := cayley.NewTransaction()
+		task := &dvmodel.Task{ID: id, Title: at.String(), Kind: dvmodel.Task_Issue, UpdatedAt: &updatedAt}
+		require.NoError(t, Snapshot(ctx, store, tx, []*dvmodel.Task{task}, SnapshotDaily, at))
+		require.NoError(t, store.ApplyTransaction(tx))
+	}
+
+	revisions, err := loadRevisions(ctx, store, id)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), revisions[0].revision.At)
+	assert.Equal(t, time.Date(2026, 1, 2, 18, 0, 0, 0, time.UTC), revisions[1].revision.At)
+}
+
+func TestParseSnapshotPolicy(t *testing.T) {
+	for input, expected := range map[string]SnapshotPolicy{"sync": SnapshotPerSync, "daily": SnapshotDaily, "off": SnapshotNone, "": SnapshotNone} {
+		policy, err := ParseSnapshotPolicy(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, policy, input)
+	}
+	_, err := ParseSnapshotPolicy("hourly")
+	assert.Error(t, err)
+}
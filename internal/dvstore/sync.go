@@ -0,0 +1,46 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/quad"
+	"moul.io/multipmuri"
+)
+
+// LastSyncedAtPredicate links a synced target to the time of its last successful pull.
+const LastSyncedAtPredicate = quad.IRI("dv:lastSyncedAt")
+
+func SetLastSyncedAt(ctx context.Context, h *cayley.Handle, targets []multipmuri.Entity, at time.Time) error {
+	tx := cayley.NewTransaction()
+	for _, target := range targets {
+		subject := quad.IRI(target.String())
+		previous, err := path.StartPath(h, subject).Out(LastSyncedAtPredicate).Iterate(ctx).AllValues(h)
+		if err != nil {
+			return fmt.Errorf("load previous sync time: %w", err)
+		}
+		for _, value := range previous {
+			tx.RemoveQuad(quad.Make(subject, LastSyncedAtPredicate, value, nil))
+		}
+		tx.AddQuad(quad.Make(subject, LastSyncedAtPredicate, quad.Time(at.UTC()), nil))
+	}
+	return h.ApplyTransaction(tx)
+}
+
+// LastSyncedAt returns the time of the last successful pull of target, or the zero time if it was never synced.
+func LastSyncedAt(ctx context.Context, h *cayley.Handle, target multipmuri.Entity) (time.Time, error) {
+	values, err := path.StartPath(h, quad.IRI(target.String())).Out(LastSyncedAtPredicate).Iterate(ctx).AllValues(h)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("load sync time: %w", err)
+	}
+	var last time.Time
+	for _, value := range values {
+		if at, ok := quad.NativeOf(value).(time.Time); ok && at.After(last) {
+			last = at
+		}
+	}
+	return last, nil
+}
@@ -0,0 +1,36 @@
+package dvstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"moul.io/depviz/v3/internal/dvparser"
+)
+
+func TestLastSyncedAt(t *testing.T) {
+	ctx := context.Background()
+	store, close := TestingStore(t)
+	defer close()
+
+	targets, err := dvparser.ParseTargets([]string{"moul/depviz-test", "moul/depviz"})
+	require.NoError(t, err)
+
+	at, err := LastSyncedAt(ctx, store, targets[0])
+	require.NoError(t, err)
+	assert.True(t, at.IsZero())
+
+	syncedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, SetLastSyncedAt(ctx, store, targets[:1], syncedAt))
+	require.NoError(t, SetLastSyncedAt(ctx, store, targets[:1], syncedAt.Add(time.Hour)))
+
+	at, err = LastSyncedAt(ctx, store, targets[0])
+	require.NoError(t, err)
+	assert.True(t, at.Equal(syncedAt.Add(time.Hour)), at)
+
+	at, err = LastSyncedAt(ctx, store, targets[1])
+	require.NoError(t, err)
+	assert.True(t, at.IsZero())
+}
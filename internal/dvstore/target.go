@@ -0,0 +1,140 @@
+package dvstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/quad"
+	"moul.io/depviz/v3/internal/dvmodel"
+	"moul.io/multipmuri"
+)
+
+// targetKind is the way a target selects tasks, see targetPath.
+type targetKind int
+
+const (
+	repoTarget targetKind = iota
+	taskTarget
+	milestoneTarget
+	labelTarget
+	userTarget
+	orgTarget
+	// ownerTarget is a user or an organization that is not in the store yet.
+	ownerTarget
+)
+
+// resolveTargetKind returns the kind of target, users and organizations are told apart with the store.
+func resolveTargetKind(ctx context.Context, h *cayley.Handle, target multipmuri.Entity) (targetKind, error) {
+	switch target.Kind() { // nolint:exhaustive
+	case multipmuri.IssueKind, multipmuri.MergeRequestKind, multipmuri.IssueOrMergeRequestKind:
+		return taskTarget, nil
+	case multipmuri.MilestoneKind:
+		return milestoneTarget, nil
+	case multipmuri.LabelKind:
+		return labelTarget, nil
+	case multipmuri.UserKind:
+		return userTarget, nil
+	case multipmuri.UserOrOrganizationKind:
+		values, err := path.StartPath(h, quad.IRI(target.String())).Out(quad.IRI("schema:kind")).Iterate(ctx).AllValues(h)
+		if err != nil {
+			return 0, fmt.Errorf("load owner kind: %w", err)
+		}
+		for _, value := range values {
+			switch kind, _ := value.(quad.Int); dvmodel.Owner_Kind(kind) { // nolint:exhaustive
+			case dvmodel.Owner_User:
+				return userTarget, nil
+			case dvmodel.Owner_Organization, dvmodel.Owner_Team:
+				return orgTarget, nil
+			}
+		}
+		return ownerTarget, nil
+	default:
+		return repoTarget, nil
+	}
+}
+
+// targetPath returns the path to the tasks selected by target:
+//
+//   - a repository selects its tasks,
+//   - an issue or a merge request selects itself, its neighbourhood comes from the dependency traversal,
+//   - a milestone selects itself and the tasks in it,
+//   - a label selects the tasks having it,
+//   - a user selects the tasks they authored or are assigned to,
+//   - an organization selects the tasks of its repositories.
+func targetPath(ctx context.Context, h *cayley.Handle, target multipmuri.Entity) (*path.Path, error) {
+	kind, err := resolveTargetKind(ctx, h, target)
+	if err != nil {
+		return nil, err
+	}
+
+	start := path.StartPath(h, quad.IRI(target.String()))
+	userTasks := start.In(quad.IRI("hasAuthor"), quad.IRI("hasAssignee"))
+	orgTasks := start.In(quad.IRI("hasOwner")).
+		Has(quad.IRI("rdf:type"), quad.IRI("dv:Owner")).
+		In(quad.IRI("hasOwner"))
+	var p *path.Path
+	switch kind {
+	case taskTarget:
+		p = start
+	case milestoneTarget:
+		p = start.Or(start.In(quad.IRI("hasMilestone")))
+	case labelTarget:
+		p = start.In(quad.IRI("hasLabel"))
+	case userTarget:
+		p = userTasks
+	case orgTarget:
+		p = orgTasks
+	case ownerTarget:
+		p = userTasks.Or(orgTasks)
+	default:
+		p = start.Both()
+	}
+	return p.Has(quad.IRI("rdf:type"), quad.IRI("dv:Task")), nil
+}
+
+// targetMatcher returns a function reporting whether a task of all is selected by target,
+// with the same semantics as targetPath.
+func targetMatcher(ctx context.Context, h *cayley.Handle, target multipmuri.Entity, all map[quad.IRI]dvmodel.Task) (func(dvmodel.Task) bool, error) {
+	kind, err := resolveTargetKind(ctx, h, target)
+	if err != nil {
+		return nil, err
+	}
+	id := quad.IRI(target.String())
+
+	// the repositories of an organization are not versioned, they are loaded from the current state
+	repos := map[quad.IRI]bool{}
+	if kind == orgTarget || kind == ownerTarget {
+		values, err := path.StartPath(h, id).
+			In(quad.IRI("hasOwner")).
+			Has(quad.IRI("rdf:type"), quad.IRI("dv:Owner")).
+			Iterate(ctx).AllValues(h)
+		if err != nil {
+			return nil, fmt.Errorf("load repositories: %w", err)
+		}
+		for _, repo := range uniqueIRIs(values) {
+			repos[repo] = true
+		}
+	}
+	isUserTask := func(task dvmodel.Task) bool {
+		return task.HasAuthor == id || containsIRI(task.HasAssignee, id)
+	}
+
+	switch kind {
+	case taskTarget:
+		return func(task dvmodel.Task) bool { return task.ID == id }, nil
+	case milestoneTarget:
+		return func(task dvmodel.Task) bool { return task.ID == id || task.HasMilestone == id }, nil
+	case labelTarget:
+		return func(task dvmodel.Task) bool { return containsIRI(task.HasLabel, id) }, nil
+	case userTarget:
+		return isUserTask, nil
+	case orgTarget:
+		return func(task dvmodel.Task) bool { return repos[task.HasOwner] }, nil
+	case ownerTarget:
+		return func(task dvmodel.Task) bool { return isUserTask(task) || repos[task.HasOwner] }, nil
+	default:
+		return func(task dvmodel.Task) bool { return containsIRI(task.AllReferences(), id) }, nil
+	}
+}
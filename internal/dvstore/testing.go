@@ -75,7 +75,7 @@ func TestingGoldenStore(t *testing.T, name string) (*cayley.Handle, func()) {
 	return store, closeFunc
 }
 
-func TestingStore(t *testing.T) (*cayley.Handle, func()) {
+func TestingStore(t testing.TB) (*cayley.Handle, func()) {
 	t.Helper()
 
 	dir, err := ioutil.TempDir("", "depviz")
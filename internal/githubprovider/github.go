@@ -17,7 +17,8 @@ type Opts struct {
 	Logger *zap.Logger `json:"-"`
 }
 
-func FetchRepo(ctx context.Context, entity multipmuri.Entity, token string, out chan<- dvmodel.Batch, opts Opts) { // nolint:interfacer
+// FetchRepo sends the issues of entity's repo to out, page by page, and returns an error if they could not all be fetched.
+func FetchRepo(ctx context.Context, entity multipmuri.Entity, token string, out chan<- dvmodel.Batch, opts Opts) error { // nolint:interfacer
 	if opts.Logger == nil {
 		opts.Logger = zap.NewNop()
 	}
@@ -27,8 +28,7 @@ func FetchRepo(ctx context.Context, entity multipmuri.Entity, token string, out
 	}
 	target, ok := entity.(multipmuriMinimalInterface)
 	if !ok {
-		opts.Logger.Warn("invalid entity", zap.String("entity", fmt.Sprintf("%v", entity.String())))
-		return
+		return fmt.Errorf("invalid entity: %v", entity.String())
 	}
 	repo := target.Repo()
 
@@ -46,8 +46,7 @@ func FetchRepo(ctx context.Context, entity multipmuri.Entity, token string, out
 	for {
 		issues, resp, err := client.Issues.ListByRepo(ctx, repo.OwnerID(), repo.RepoID(), callOpts)
 		if err != nil {
-			opts.Logger.Error("fetch GitHub issues", zap.Error(err))
-			return
+			return fmt.Errorf("fetch GitHub issues: %w", err)
 		}
 		totalIssues += len(issues)
 		opts.Logger.Debug("paginate",
@@ -75,4 +74,5 @@ func FetchRepo(ctx context.Context, entity multipmuri.Entity, token string, out
 	}
 
 	// FIXME: fetch incomplete/old users, orgs, teams & repos
+	return nil
 }
@@ -6,7 +6,6 @@ import (
 
 	"github.com/cayleygraph/quad"
 	"github.com/google/go-github/v30/github"
-	"github.com/xhit/go-str2duration/v2"
 	"go.uber.org/zap"
 	"moul.io/depviz/v3/internal/dvmodel"
 	"moul.io/depviz/v3/internal/dvparser"
@@ -152,13 +151,12 @@ func fromIssue(batch *dvmodel.Batch, input *github.Issue) error {
 }
 
 func parseDuration(body string) string {
-	compile := regexp.MustCompile(`time[ \t]+([w|d|h|m|0-9]+)`)
+	compile := regexp.MustCompile(`time[ \t]+([w|d|h|m|0-9/]+)`)
 	match := compile.FindStringSubmatch(body)
 	if len(match) < 2 || len(match[1]) == 0 {
 		return UndefinedDuration
 	}
-	_, err := str2duration.ParseDuration(match[1])
-	if err != nil {
+	if _, err := dvmodel.ParseEstimate(match[1]); err != nil {
 		return InvalidDuration
 	}
 	return match[1]
@@ -195,6 +193,7 @@ func fromUser(batch *dvmodel.Batch, input *github.User) (*dvmodel.Owner, error)
 		AvatarURL:   input.GetAvatarURL(),
 		ForkStatus:  dvmodel.Owner_UnknownForkStatus,
 		Description: description,
+		Email:       input.GetEmail(),
 	}
 	if input.CreatedAt != nil {
 		created := input.GetCreatedAt().Time
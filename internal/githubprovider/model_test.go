@@ -0,0 +1,25 @@
+package githubprovider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+	}{
+		{"some description\ntime 2d\n", "2d"},
+		{"time\t1w3d", "1w3d"},
+		{"time 2d/3d/1w", "2d/3d/1w"},
+		{"time 2d/3d", InvalidDuration},
+		{"time 3d/2d/1w", InvalidDuration},
+		{"time 2x", InvalidDuration}, // the regexp stops before the unknown unit
+		{"no estimate", UndefinedDuration},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, parseDuration(test.body), test.body)
+	}
+}
@@ -33,6 +33,7 @@ const App = () => {
     withoutPrs: searchParams.get('withoutPrs') === 'false',
     withoutExternalDeps: searchParams.get('withoutExternalDeps') === 'false',
     layout: searchParams.get('layout') || '',
+    filter: searchParams.get('filter') || '',
   }
 
   const handleChange = (e) => {
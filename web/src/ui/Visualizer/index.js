@@ -23,11 +23,22 @@ const VisualizerWrapper = () => {
   const withClosed =(new URLSearchParams(window.location.search).get('withClosed')) === 'true'
 
 
-  const { tasks } = apiData || {}
+  const { tasks, cycles } = apiData || {}
 
   const nodes = []
   const edges = []
 
+  // dependencies that are part of a cycle, as "task depends_on" keys
+  const cycleDependencies = new Set()
+  if (cycles) {
+    cycles.forEach((cycle) => {
+      (cycle.dependencies || []).forEach((dep) => {
+        cycleDependencies.add(`${dep.task} ${dep.depends_on}`)
+      })
+    })
+  }
+  const dependencyClasses = (task, dependsOn) => (cycleDependencies.has(`${task} ${dependsOn}`) ? 'cycle' : '')
+
   useEffect(() => {
     if (showDebug) {
       const stats = new Stats()
@@ -131,6 +142,7 @@ const VisualizerWrapper = () => {
                 target: other,
                 relation: 'is_depending_on',
               },
+              classes: dependencyClasses(task.id, other),
             })
           }
         })
@@ -144,6 +156,7 @@ const VisualizerWrapper = () => {
                 target: task.id,
                 relation: 'is_depending_on',
               },
+              classes: dependencyClasses(other, task.id),
             })
           }
         })
@@ -240,6 +253,15 @@ const VisualizerWrapper = () => {
 
   return (
     <div>
+      {cycles && cycles.length > 0 && (
+        <div className="cycles-warning">
+          {cycles.length}
+          {' '}
+          dependency cycle(s):
+          {' '}
+          {cycles.map((cycle) => cycle.path.join(' → ')).join(', ')}
+        </div>
+      )}
       <div className="viz-wrapper card">
         <ErrorBoundary>
           {rendererBlock}
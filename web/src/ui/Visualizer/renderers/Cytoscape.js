@@ -73,6 +73,13 @@ const CytoscapeRenderer = ({
           'target-arrow-fill': 'hollow',
         },
       },
+      {
+        selector: 'edge.cycle',
+        style: {
+          'line-color': 'orange',
+          'target-arrow-color': 'orange',
+        },
+      },
       ],
       layout,
     }
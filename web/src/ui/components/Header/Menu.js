@@ -351,6 +351,12 @@ const Menu = ({
               </label>
             </div>
 
+            <div className="form-group">
+              <label htmlFor="filter" className="form-label">
+                <input ref={register} type="text" name="filter" id="filter" placeholder="Filter, e.g. label:backend -label:wontfix assignee:alice" className="form-control" />
+              </label>
+            </div>
+
             <div className="form-group layout-select">
               <label htmlFor="layout">
                 <span className="custom-control">Layout:</span>
@@ -10,7 +10,7 @@ export const generateUrl = (data) => {
       if (key === 'targets') {
         url += `${data[key].split(',').map((target) => `targets=${target.trim()}`).join('&')}`
       } else {
-        url += `${url.length === 1 ? '' : '&'}${key}=${data[key]}`
+        url += `${url.length === 1 ? '' : '&'}${key}=${encodeURIComponent(data[key])}`
       }
     }
   })